@@ -0,0 +1,66 @@
+package godash
+
+import (
+	"reflect"
+)
+
+// Chunk splits the provided slice into consecutive chunks of the given size. The last chunk holds the remaining elements and may be shorter.
+// The chunks share the backing array of the given slice, but have their capacity limited so appending to one does not overwrite the next.
+// The new [][]T slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func Chunk(slice interface{}, size int) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("Chunk", 1, slice)
+	}
+	if size <= 0 {
+		return nil, invalidSizeError("Chunk", 2, size)
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(sliceVal.Type()), 0, (sliceVal.Len()+size-1)/size)
+	for i := 0; i < sliceVal.Len(); i += size {
+		end := i + size
+		if end > sliceVal.Len() {
+			end = sliceVal.Len()
+		}
+		dest = reflect.Append(dest, sliceVal.Slice3(i, end, end))
+	}
+	return dest.Interface(), nil
+
+}
+
+// Window creates the sliding windows of the given size over the provided slice, starting a new window every step elements.
+// Only full windows are returned, so a slice shorter than size results in no windows.
+// The windows share the backing array of the given slice, but have their capacity limited so appending to one does not overwrite another.
+// The new [][]T slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func Window(slice interface{}, size int, step int) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("Window", 1, slice)
+	}
+	if size <= 0 {
+		return nil, invalidSizeError("Window", 2, size)
+	}
+	if step <= 0 {
+		return nil, invalidSizeError("Window", 3, step)
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(sliceVal.Type()), 0, 0)
+	for i := 0; i+size <= sliceVal.Len(); i += step {
+		dest = reflect.Append(dest, sliceVal.Slice3(i, i+size, i+size))
+	}
+	return dest.Interface(), nil
+
+}
+
+// Pairwise creates a slice of the pairs of adjacent elements of the provided slice, i.e. its sliding windows of size 2.
+// The new [][]T slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func Pairwise(slice interface{}) (interface{}, error) {
+
+	if reflect.ValueOf(slice).Kind() != reflect.Slice {
+		return nil, notSliceError("Pairwise", 1, slice)
+	}
+	return Window(slice, 2, 1)
+
+}
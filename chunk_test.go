@@ -0,0 +1,121 @@
+package godash_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestChunk(t *testing.T) {
+
+	// test for success
+	chunks, err := godash.Chunk([]int{1, 2, 3, 4, 5}, 2)
+	expected := [][]int{{1, 2}, {3, 4}, {5}}
+	if err != nil {
+		t.Errorf("Expected Chunk to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(chunks, expected) {
+		t.Errorf("Expected Chunk to return %v, but it returned %v", expected, chunks)
+	}
+
+	// test for appending to a chunk
+	source := []int{1, 2, 3, 4}
+	chunks, _ = godash.Chunk(source, 2)
+	_ = append(chunks.([][]int)[0], 9)
+	if source[2] != 3 {
+		t.Errorf("Expected appending to a chunk to leave the source intact, but it is %v", source)
+	}
+
+	// test for empty slice
+	chunks, err = godash.Chunk([]string{}, 3)
+	if err != nil {
+		t.Errorf("Expected Chunk to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(chunks, [][]string{}) {
+		t.Errorf("Expected Chunk to return empty slice, but it returned %v", chunks)
+	}
+
+	// test for failure
+	chunks, err = godash.Chunk([]int{1, 2}, 0)
+	if !errors.Is(err, godash.ErrInvalidSize) {
+		t.Errorf("Expected Chunk to return ErrInvalidSize, but got %v", err)
+	}
+	if chunks != nil {
+		t.Errorf("Expected Chunk to return nil result, but got %v", chunks)
+	}
+	chunks, err = godash.Chunk(1, 2)
+	if !errors.Is(err, godash.ErrNotSlice) {
+		t.Errorf("Expected Chunk to return ErrNotSlice, but got %v", err)
+	}
+	if chunks != nil {
+		t.Errorf("Expected Chunk to return nil result, but got %v", chunks)
+	}
+
+}
+
+func TestWindow(t *testing.T) {
+
+	// test for success
+	windows, err := godash.Window([]int{1, 2, 3, 4, 5}, 3, 1)
+	expected := [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+	if err != nil {
+		t.Errorf("Expected Window to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(windows, expected) {
+		t.Errorf("Expected Window to return %v, but it returned %v", expected, windows)
+	}
+
+	// test for step success
+	windows, err = godash.Window([]int{1, 2, 3, 4, 5, 6}, 2, 3)
+	expected = [][]int{{1, 2}, {4, 5}}
+	if err != nil {
+		t.Errorf("Expected Window to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(windows, expected) {
+		t.Errorf("Expected Window to return %v, but it returned %v", expected, windows)
+	}
+
+	// test for short slice
+	windows, err = godash.Window([]int{1, 2}, 3, 1)
+	if err != nil {
+		t.Errorf("Expected Window to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(windows, [][]int{}) {
+		t.Errorf("Expected Window to return empty slice, but it returned %v", windows)
+	}
+
+	// test for failure
+	windows, err = godash.Window([]int{1, 2}, 1, -1)
+	if !errors.Is(err, godash.ErrInvalidSize) {
+		t.Errorf("Expected Window to return ErrInvalidSize, but got %v", err)
+	}
+	if windows != nil {
+		t.Errorf("Expected Window to return nil result, but got %v", windows)
+	}
+
+}
+
+func TestPairwise(t *testing.T) {
+
+	// test for success
+	pairs, err := godash.Pairwise([]string{"a", "b", "c"})
+	expected := [][]string{{"a", "b"}, {"b", "c"}}
+	if err != nil {
+		t.Errorf("Expected Pairwise to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("Expected Pairwise to return %v, but it returned %v", expected, pairs)
+	}
+
+	// test for failure
+	pairs, err = godash.Pairwise("abc")
+	if err == nil {
+		t.Error("Expected Pairwise to return error")
+	}
+	if pairs != nil {
+		t.Errorf("Expected Pairwise to return nil result, but got %v", pairs)
+	}
+
+}
@@ -0,0 +1,76 @@
+package godash
+
+import (
+	"reflect"
+)
+
+// Difference creates a slice of the values of the first slice that are not present in the second slice.
+// The order of the items in the resulting slice is determined by the first given slice, and duplicates within it are kept.
+// Elements that cannot be used as map keys, such as slices or maps, are compared with reflect.DeepEqual.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func Difference(slice1 interface{}, slice2 interface{}) (interface{}, error) {
+
+	sliceVal1 := reflect.ValueOf(slice1)
+	sliceVal2 := reflect.ValueOf(slice2)
+
+	if sliceVal1.Kind() != reflect.Slice {
+		return nil, notSliceError("Difference", 1, slice1)
+	}
+	if sliceVal2.Kind() != reflect.Slice {
+		return nil, notSliceError("Difference", 2, slice2)
+	}
+	if sliceVal1.Type().Elem() != sliceVal2.Type().Elem() {
+		return nil, typeMismatchError("Difference", 2, reflect.SliceOf(sliceVal1.Type().Elem()), slice2)
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice1).Elem()), 0, sliceVal1.Len())
+	m := newValueMap(sliceVal2.Len())
+
+	for i := 0; i < sliceVal2.Len(); i++ {
+		m.add(sliceVal2.Index(i).Interface())
+	}
+	for i := 0; i < sliceVal1.Len(); i++ {
+		if !m.has(sliceVal1.Index(i).Interface()) {
+			dest = reflect.Append(dest, sliceVal1.Index(i))
+		}
+	}
+	return dest.Interface(), nil
+
+}
+
+// DifferenceBy passes items from two provided slices through a provided mutator function and creates a new slice with the items of the first slice whose mutated values are not present in the second slice.
+// The supplied mutator function must accept an interface{} parameter and return interface{} with the value to be compared.
+// The order and values of the items in the resulting slice are determined by the first given slice.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func DifferenceBy(slice1 interface{}, slice2 interface{}, fn mutator) (interface{}, error) {
+
+	sliceVal1 := reflect.ValueOf(slice1)
+	sliceVal2 := reflect.ValueOf(slice2)
+
+	if sliceVal1.Kind() != reflect.Slice {
+		return nil, notSliceError("DifferenceBy", 1, slice1)
+	}
+	if sliceVal2.Kind() != reflect.Slice {
+		return nil, notSliceError("DifferenceBy", 2, slice2)
+	}
+	if sliceVal1.Type().Elem() != sliceVal2.Type().Elem() {
+		return nil, typeMismatchError("DifferenceBy", 2, reflect.SliceOf(sliceVal1.Type().Elem()), slice2)
+	}
+	if fn == nil {
+		return nil, nilFuncError("DifferenceBy", 3)
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice1).Elem()), 0, sliceVal1.Len())
+	m := newValueMap(sliceVal2.Len())
+
+	for i := 0; i < sliceVal2.Len(); i++ {
+		m.add(fn(sliceVal2.Index(i).Interface()))
+	}
+	for i := 0; i < sliceVal1.Len(); i++ {
+		if !m.has(fn(sliceVal1.Index(i).Interface())) {
+			dest = reflect.Append(dest, sliceVal1.Index(i))
+		}
+	}
+	return dest.Interface(), nil
+
+}
@@ -0,0 +1,103 @@
+package godash_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestDifference(t *testing.T) {
+
+	// test int success
+	intSlice, err := godash.Difference([]int{3, 17, 8, 3, 11}, []int{8, 5, 17})
+	intExpected := []int{3, 3, 11}
+	if err != nil {
+		t.Errorf("Expected Difference to return no error, but got %v", err)
+	}
+	if reflect.TypeOf(intSlice).Kind() != reflect.Slice {
+		t.Error("Expected Difference to return slice")
+	}
+	if !reflect.DeepEqual(intSlice, intExpected) {
+		t.Errorf("Expected Difference to return %v, but it returned %v", intExpected, intSlice)
+	}
+
+	// test unhashable success
+	nestedSlice, err := godash.Difference([][]int{{1, 2}, {3}}, [][]int{{3}, {4}})
+	nestedExpected := [][]int{{1, 2}}
+	if err != nil {
+		t.Errorf("Expected Difference to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(nestedSlice, nestedExpected) {
+		t.Errorf("Expected Difference to return %v, but it returned %v", nestedExpected, nestedSlice)
+	}
+
+	// test failure
+	result, err := godash.Difference(1, []int{1, 2})
+	if err == nil {
+		t.Error("Expected Difference to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected Difference to return nil result, but got %v", result)
+	}
+	result, err = godash.Difference([]int{1, 2}, 1)
+	if err == nil {
+		t.Error("Expected Difference to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected Difference to return nil result, but got %v", result)
+	}
+	result, err = godash.Difference([]float32{1, 2}, []int{1, 2})
+	if err == nil {
+		t.Error("Expected Difference to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected Difference to return nil result, but got %v", result)
+	}
+
+}
+
+func TestDifferenceBy(t *testing.T) {
+
+	fn := func(x interface{}) interface{} {
+		return math.Floor(x.(float64))
+	}
+
+	// test float success
+	floatSlice, err := godash.DifferenceBy([]float64{2.16, 1.23, 5.4}, []float64{5.78, 2.49, 3.7}, fn)
+	floatExpected := []float64{1.23}
+	if err != nil {
+		t.Errorf("Expected DifferenceBy to return no error, but got %v", err)
+	}
+	if reflect.TypeOf(floatSlice).Kind() != reflect.Slice {
+		t.Error("Expected DifferenceBy to return slice")
+	}
+	if !reflect.DeepEqual(floatSlice, floatExpected) {
+		t.Errorf("Expected DifferenceBy to return %v, but it returned %v", floatExpected, floatSlice)
+	}
+
+	// test failure
+	result, err := godash.DifferenceBy(1, []int{1, 2}, fn)
+	if err == nil {
+		t.Error("Expected DifferenceBy to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected DifferenceBy to return nil result, but got %v", result)
+	}
+	result, err = godash.DifferenceBy([]int{1, 2}, 1, fn)
+	if err == nil {
+		t.Error("Expected DifferenceBy to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected DifferenceBy to return nil result, but got %v", result)
+	}
+	result, err = godash.DifferenceBy([]float32{1, 2}, []int{1, 2}, fn)
+	if err == nil {
+		t.Error("Expected DifferenceBy to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected DifferenceBy to return nil result, but got %v", result)
+	}
+
+}
@@ -0,0 +1,81 @@
+package godash
+
+import (
+	"reflect"
+)
+
+// Equaler is implemented by types that define their own notion of equality.
+// When the element type of a slice implements Equaler, FindIndex, FindLastIndex, Without and the functions built on them
+// call Equal with the value being searched for or removed instead of comparing the two with reflect.DeepEqual.
+// Types with a method Equal that takes their own type, such as time.Time, are honored in the same way.
+type Equaler interface {
+	Equal(other interface{}) bool
+}
+
+var equalerType = reflect.TypeOf((*Equaler)(nil)).Elem()
+
+// equalFunc returns the equality function for elements of type t, based on its Equaler implementation or its
+// Equal(t) bool method. It returns nil when t has neither, in which case reflect.DeepEqual applies.
+// Nil elements and values are compared with deepEqual, as Equal may not accept a nil receiver or argument.
+func equalFunc(t reflect.Type) comparator {
+
+	if t.Implements(equalerType) {
+		return func(a, b interface{}) bool {
+			if isNil(a) || isNil(b) {
+				return deepEqual(a, b)
+			}
+			return a.(Equaler).Equal(b)
+		}
+	}
+
+	// for interface types the method has no receiver parameter, so NumIn rules them out
+	m, ok := t.MethodByName("Equal")
+	if !ok || m.Type.NumIn() != 2 || m.Type.In(1) != t || m.Type.NumOut() != 1 || m.Type.Out(0).Kind() != reflect.Bool {
+		return nil
+	}
+	return func(a, b interface{}) bool {
+		if isNil(a) || isNil(b) {
+			return deepEqual(a, b)
+		}
+		bVal := reflect.ValueOf(b)
+		if bVal.Type() != t {
+			return false
+		}
+		return m.Func.Call([]reflect.Value{reflect.ValueOf(a), bVal})[0].Bool()
+	}
+
+}
+
+// equalTo returns a function reporting whether an element of type t equals value,
+// using the equality defined by t if it has one and deepEqual otherwise.
+func equalTo(t reflect.Type, value interface{}) func(interface{}) bool {
+
+	if eq := equalFunc(t); eq != nil {
+		return func(x interface{}) bool {
+			return eq(x, value)
+		}
+	}
+	return func(x interface{}) bool {
+		return deepEqual(x, value)
+	}
+
+}
+
+// deepEqual reports whether a and b are deeply equal, treating NaNs of the same float type as equal.
+func deepEqual(a, b interface{}) bool {
+	return reflect.DeepEqual(normalizeNaN(a), normalizeNaN(b))
+}
+
+// isNil reports whether v is nil or a nil pointer, interface, map, slice, channel or function.
+func isNil(v interface{}) bool {
+
+	val := reflect.ValueOf(v)
+	switch val.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return val.IsNil()
+	}
+	return false
+
+}
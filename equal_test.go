@@ -0,0 +1,153 @@
+package godash_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zillow/godash"
+)
+
+// caseless is a string that compares equal to other caseless strings regardless of case
+type caseless string
+
+func (c caseless) Equal(other interface{}) bool {
+	o, ok := other.(caseless)
+	return ok && strings.EqualFold(string(c), string(o))
+}
+
+func TestEqualer(t *testing.T) {
+
+	source := []caseless{"One", "two", "THREE", "one"}
+
+	// test for FindIndex success
+	index, err := godash.FindIndex(source, caseless("ONE"))
+	if err != nil {
+		t.Errorf("Expected FindIndex to return no error, but got %v", err)
+	}
+	if index != 0 {
+		t.Errorf("Expected FindIndex to return %v, but it returned %v", 0, index)
+	}
+
+	// test for FindLastIndex success
+	index, err = godash.FindLastIndex(source, caseless("ONE"))
+	if err != nil {
+		t.Errorf("Expected FindLastIndex to return no error, but got %v", err)
+	}
+	if index != 3 {
+		t.Errorf("Expected FindLastIndex to return %v, but it returned %v", 3, index)
+	}
+
+	// test for Without success
+	result, err := godash.Without(source, caseless("one"), caseless("three"))
+	if err != nil {
+		t.Errorf("Expected Without to return no error, but got %v", err)
+	}
+	expected := []caseless{"two"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected Without to return %v, but it returned %v", expected, result)
+	}
+
+}
+
+func TestEqualMethod(t *testing.T) {
+
+	instant := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	zone := time.FixedZone("UTC-5", -5*60*60)
+	source := []time.Time{instant.Add(-time.Hour), instant, instant.Add(time.Hour)}
+
+	// test for FindIndex success
+	index, err := godash.FindIndex(source, instant.In(zone))
+	if err != nil {
+		t.Errorf("Expected FindIndex to return no error, but got %v", err)
+	}
+	if index != 1 {
+		t.Errorf("Expected FindIndex to return %v, but it returned %v", 1, index)
+	}
+
+	// test for Without success
+	result, err := godash.Without(source, instant.In(zone))
+	if err != nil {
+		t.Errorf("Expected Without to return no error, but got %v", err)
+	}
+	expected := []time.Time{source[0], source[2]}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected Without to return %v, but it returned %v", expected, result)
+	}
+
+	// test for value of another type
+	index, err = godash.FindIndex(source, "2020-01-01")
+	if err != nil {
+		t.Errorf("Expected FindIndex to return no error, but got %v", err)
+	}
+	if index != -1 {
+		t.Errorf("Expected FindIndex to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+// version compares equal to other versions with the same major number
+type version struct {
+	major, minor int
+}
+
+func (v version) Equal(other interface{}) bool {
+	o, ok := other.(*version)
+	return ok && v.major == o.major
+}
+
+// box has a typed Equal method on its pointer
+type box struct {
+	size int
+}
+
+func (b *box) Equal(other *box) bool {
+	return b.size == other.size
+}
+
+func TestEqualNil(t *testing.T) {
+
+	versions := []*version{nil, {major: 1, minor: 2}}
+
+	// test for Equaler with nil elements
+	index, err := godash.FindIndex(versions, &version{major: 1})
+	if err != nil {
+		t.Errorf("Expected FindIndex to return no error, but got %v", err)
+	}
+	if index != 1 {
+		t.Errorf("Expected FindIndex to return %v, but it returned %v", 1, index)
+	}
+	index, err = godash.FindLastIndex(versions, (*version)(nil))
+	if err != nil {
+		t.Errorf("Expected FindLastIndex to return no error, but got %v", err)
+	}
+	if index != 0 {
+		t.Errorf("Expected FindLastIndex to return %v, but it returned %v", 0, index)
+	}
+	result, err := godash.Without(versions, (*version)(nil))
+	if err != nil {
+		t.Errorf("Expected Without to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(result, versions[1:]) {
+		t.Errorf("Expected Without to return %v, but it returned %v", versions[1:], result)
+	}
+
+	// test for typed Equal method with nil elements
+	boxes := []*box{nil, {size: 2}}
+	index, err = godash.FindIndex(boxes, &box{size: 2})
+	if err != nil {
+		t.Errorf("Expected FindIndex to return no error, but got %v", err)
+	}
+	if index != 1 {
+		t.Errorf("Expected FindIndex to return %v, but it returned %v", 1, index)
+	}
+	included, err := godash.Includes(boxes[1:], (*box)(nil))
+	if err != nil {
+		t.Errorf("Expected Includes to return no error, but got %v", err)
+	}
+	if included {
+		t.Error("Expected Includes to return false")
+	}
+
+}
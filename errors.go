@@ -0,0 +1,91 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+var (
+	// ErrNotSlice is reported when a parameter that must be a slice is not one.
+	ErrNotSlice = errors.New("godash: parameter is not a slice")
+
+	// ErrTypeMismatch is reported when a parameter does not match the element type of the slice it is used with.
+	ErrTypeMismatch = errors.New("godash: parameter types do not match")
+
+	// ErrInvalidSize is reported when a size or step parameter is not a positive number.
+	ErrInvalidSize = errors.New("godash: size is not positive")
+
+	// ErrInvalidTolerance is reported when a tolerance parameter is negative or NaN.
+	ErrInvalidTolerance = errors.New("godash: tolerance is negative or NaN")
+
+	// ErrLengthMismatch is reported when two parameters that must have the same number of elements do not.
+	ErrLengthMismatch = errors.New("godash: parameter lengths do not match")
+
+	// ErrNilFunc is reported when a nil validator, mutator or comparator function is passed.
+	ErrNilFunc = errors.New("godash: function parameter is nil")
+)
+
+// ArgumentError describes an invalid parameter passed to a godash function.
+// It wraps one of the package's sentinel errors, such as ErrNotSlice or ErrTypeMismatch,
+// so callers can match it with errors.Is, or inspect its fields with errors.As.
+type ArgumentError struct {
+	Func     string // name of the function that was called, e.g. "Uniq"
+	Param    int    // 1-based position of the invalid parameter
+	Expected string // description of what the parameter was expected to be
+	Got      string // description of what the parameter was
+	Err      error  // sentinel error describing the kind of problem
+}
+
+func (e *ArgumentError) Error() string {
+	return fmt.Sprintf("godash: invalid parameter type. %s func expects parameter %d to be %s, but got %s", e.Func, e.Param, e.Expected, e.Got)
+}
+
+// Unwrap returns the sentinel error describing the kind of problem.
+func (e *ArgumentError) Unwrap() error {
+	return e.Err
+}
+
+// notSliceError reports that parameter param of function fn, whose value was got, is not a slice.
+func notSliceError(fn string, param int, got interface{}) error {
+	return &ArgumentError{Func: fn, Param: param, Expected: "a slice", Got: typeName(got), Err: ErrNotSlice}
+}
+
+// notSlicePointerError reports that parameter param of function fn, whose value was got, is not a non-nil pointer to a slice.
+func notSlicePointerError(fn string, param int, got interface{}) error {
+	return &ArgumentError{Func: fn, Param: param, Expected: "a non-nil pointer to a slice", Got: typeName(got), Err: ErrNotSlice}
+}
+
+// notFloatSliceError reports that parameter param of function fn, whose value was got, is not a slice of float32 or float64.
+func notFloatSliceError(fn string, param int, got interface{}) error {
+	return &ArgumentError{Func: fn, Param: param, Expected: "a slice of float32 or float64", Got: typeName(got), Err: ErrTypeMismatch}
+}
+
+// typeMismatchError reports that parameter param of function fn, whose value was got, is not of type expected.
+func typeMismatchError(fn string, param int, expected reflect.Type, got interface{}) error {
+	return &ArgumentError{Func: fn, Param: param, Expected: expected.String(), Got: typeName(got), Err: ErrTypeMismatch}
+}
+
+// nilFuncError reports that the function parameter param of function fn is nil.
+func nilFuncError(fn string, param int) error {
+	return &ArgumentError{Func: fn, Param: param, Expected: "a non-nil function", Got: "nil", Err: ErrNilFunc}
+}
+
+// invalidSizeError reports that parameter param of function fn, whose value was got, is not a positive size.
+func invalidSizeError(fn string, param int, got int) error {
+	return &ArgumentError{Func: fn, Param: param, Expected: "a positive number", Got: strconv.Itoa(got), Err: ErrInvalidSize}
+}
+
+// invalidToleranceError reports that parameter param of function fn, whose value was got, is not a valid tolerance.
+func invalidToleranceError(fn string, param int, got float64) error {
+	return &ArgumentError{Func: fn, Param: param, Expected: "a non-negative number", Got: strconv.FormatFloat(got, 'g', -1, 64), Err: ErrInvalidTolerance}
+}
+
+// typeName describes the dynamic type of v for use in error messages.
+func typeName(v interface{}) string {
+	if v == nil {
+		return "nil"
+	}
+	return reflect.TypeOf(v).String()
+}
@@ -0,0 +1,137 @@
+package godash_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestArgumentError(t *testing.T) {
+
+	// test not a slice
+	_, err := godash.FindLastBy(str{name: "value"}, func(x interface{}) bool { return true })
+	if !errors.Is(err, godash.ErrNotSlice) {
+		t.Errorf("Expected FindLastBy to return ErrNotSlice, but got %v", err)
+	}
+	var argErr *godash.ArgumentError
+	if !errors.As(err, &argErr) {
+		t.Fatalf("Expected FindLastBy to return *ArgumentError, but got %T", err)
+	}
+	if argErr.Func != "FindLastBy" || argErr.Param != 1 || argErr.Got != "godash_test.str" {
+		t.Errorf("Expected FindLastBy to describe parameter 1 of FindLastBy, but got %+v", argErr)
+	}
+	expected := "godash: invalid parameter type. FindLastBy func expects parameter 1 to be a slice, but got godash_test.str"
+	if err.Error() != expected {
+		t.Errorf("Expected error message %q, but got %q", expected, err.Error())
+	}
+
+	// test mismatched slices
+	_, err = godash.Intersection([]int{1, 2}, []float32{1, 2})
+	if !errors.Is(err, godash.ErrTypeMismatch) {
+		t.Errorf("Expected Intersection to return ErrTypeMismatch, but got %v", err)
+	}
+	if errors.Is(err, godash.ErrNotSlice) {
+		t.Error("Expected Intersection not to return ErrNotSlice")
+	}
+	if !errors.As(err, &argErr) {
+		t.Fatalf("Expected Intersection to return *ArgumentError, but got %T", err)
+	}
+	if argErr.Param != 2 || argErr.Expected != "[]int" || argErr.Got != "[]float32" {
+		t.Errorf("Expected Intersection to describe parameter 2 as []int, but got %+v", argErr)
+	}
+
+	// test mismatched values
+	_, err = godash.Without([]string{"one", "two"}, "one", 2)
+	if !errors.Is(err, godash.ErrTypeMismatch) {
+		t.Errorf("Expected Without to return ErrTypeMismatch, but got %v", err)
+	}
+	if !errors.As(err, &argErr) {
+		t.Fatalf("Expected Without to return *ArgumentError, but got %T", err)
+	}
+	if argErr.Param != 3 || argErr.Expected != "string" || argErr.Got != "int" {
+		t.Errorf("Expected Without to describe parameter 3 as string, but got %+v", argErr)
+	}
+
+}
+
+func TestNilInputs(t *testing.T) {
+
+	validatorFn := func(x interface{}) bool { return true }
+	mutatorFn := func(x interface{}) interface{} { return x }
+	comparatorFn := func(a, b interface{}) bool { return true }
+
+	// test untyped nil slices
+	untypedNil := map[string]func() (interface{}, error){
+		"FindBy":          func() (interface{}, error) { return godash.FindBy(nil, validatorFn) },
+		"FindLastBy":      func() (interface{}, error) { return godash.FindLastBy(nil, validatorFn) },
+		"FindIndex":       func() (interface{}, error) { return godash.FindIndex(nil, 1) },
+		"FindIndexBy":     func() (interface{}, error) { return godash.FindIndexBy(nil, validatorFn) },
+		"FindLastIndex":   func() (interface{}, error) { return godash.FindLastIndex(nil, 1) },
+		"Uniq":            func() (interface{}, error) { return godash.Uniq(nil) },
+		"UniqBy":          func() (interface{}, error) { return godash.UniqBy(nil, mutatorFn) },
+		"UniqWith":        func() (interface{}, error) { return godash.UniqWith(nil, comparatorFn) },
+		"Intersection":    func() (interface{}, error) { return godash.Intersection([]int{1}, nil) },
+		"IntersectionBy":  func() (interface{}, error) { return godash.IntersectionBy(nil, []int{1}, mutatorFn) },
+		"IntersectionN":   func() (interface{}, error) { return godash.IntersectionN([]int{1}, nil) },
+		"IntersectionNBy": func() (interface{}, error) { return godash.IntersectionNBy(mutatorFn, nil) },
+		"Union":           func() (interface{}, error) { return godash.Union(nil, nil) },
+		"UnionBy":         func() (interface{}, error) { return godash.UnionBy([]int{1}, nil, mutatorFn) },
+		"Difference":      func() (interface{}, error) { return godash.Difference(nil, []int{1}) },
+		"DifferenceBy":    func() (interface{}, error) { return godash.DifferenceBy(nil, []int{1}, mutatorFn) },
+		"Xor":             func() (interface{}, error) { return godash.Xor([]int{1}, nil) },
+		"XorBy":           func() (interface{}, error) { return godash.XorBy(nil, []int{1}, mutatorFn) },
+		"Without":         func() (interface{}, error) { return godash.Without(nil, 1) },
+		"WithoutBy":       func() (interface{}, error) { return godash.WithoutBy(nil, validatorFn) },
+	}
+	for name, call := range untypedNil {
+		if _, err := call(); !errors.Is(err, godash.ErrNotSlice) {
+			t.Errorf("Expected %s to return ErrNotSlice for a nil slice, but got %v", name, err)
+		}
+	}
+
+	// test nil functions
+	nilFunc := map[string]func() (interface{}, error){
+		"FindBy":          func() (interface{}, error) { return godash.FindBy([]int{1}, nil) },
+		"FindLastBy":      func() (interface{}, error) { return godash.FindLastBy([]int{1}, nil) },
+		"FindIndexBy":     func() (interface{}, error) { return godash.FindIndexBy([]int{1}, nil) },
+		"UniqBy":          func() (interface{}, error) { return godash.UniqBy([]int{1}, nil) },
+		"UniqWith":        func() (interface{}, error) { return godash.UniqWith([]int{1}, nil) },
+		"IntersectionBy":  func() (interface{}, error) { return godash.IntersectionBy([]int{1}, []int{1}, nil) },
+		"IntersectionNBy": func() (interface{}, error) { return godash.IntersectionNBy(nil, []int{1}) },
+		"UnionBy":         func() (interface{}, error) { return godash.UnionBy([]int{1}, []int{1}, nil) },
+		"DifferenceBy":    func() (interface{}, error) { return godash.DifferenceBy([]int{1}, []int{1}, nil) },
+		"XorBy":           func() (interface{}, error) { return godash.XorBy([]int{1}, []int{1}, nil) },
+		"WithoutBy":       func() (interface{}, error) { return godash.WithoutBy([]int{1}, nil) },
+	}
+	for name, call := range nilFunc {
+		if _, err := call(); !errors.Is(err, godash.ErrNilFunc) {
+			t.Errorf("Expected %s to return ErrNilFunc for a nil function, but got %v", name, err)
+		}
+	}
+
+	// test typed nil slices
+	var nilSlice []int
+	result, err := godash.Uniq(nilSlice)
+	if err != nil {
+		t.Errorf("Expected Uniq to return no error, but got %v", err)
+	}
+	if result == nil || len(result.([]int)) != 0 {
+		t.Errorf("Expected Uniq to return empty slice, but got %v", result)
+	}
+	result, err = godash.Intersection(nilSlice, []int{1, 2})
+	if err != nil {
+		t.Errorf("Expected Intersection to return no error, but got %v", err)
+	}
+	if result == nil || len(result.([]int)) != 0 {
+		t.Errorf("Expected Intersection to return empty slice, but got %v", result)
+	}
+	result, err = godash.FindBy(nilSlice, validatorFn)
+	if err != nil {
+		t.Errorf("Expected FindBy to return no error, but got %v", err)
+	}
+	if result != nil {
+		t.Errorf("Expected FindBy to return no value, but it returned %v", result)
+	}
+
+}
@@ -0,0 +1,30 @@
+package godash
+
+import (
+	"reflect"
+)
+
+// Filter creates a slice of the elements of the provided slice that the provided validator function returns true for.
+// The supplied function must accept an interface{} parameter and return bool.
+// The order of the items in the resulting slice is determined by the given slice.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func Filter(slice interface{}, fn validator) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("Filter", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("Filter", 2)
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice).Elem()), 0, sliceVal.Len())
+
+	for i := 0; i < sliceVal.Len(); i++ {
+		if keep := fn(sliceVal.Index(i).Interface()); keep {
+			dest = reflect.Append(dest, sliceVal.Index(i))
+		}
+	}
+	return dest.Interface(), nil
+
+}
@@ -0,0 +1,60 @@
+package godash_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestFilter(t *testing.T) {
+
+	intFn := func(x interface{}) bool {
+		return x.(int)%2 == 0
+	}
+	structFn := func(x interface{}) bool {
+		return x.(str).foo == "bar"
+	}
+
+	// test for int success
+	intSlice, err := godash.Filter([]int{1, 2, 3, 4, 5, 6}, intFn)
+	intExpected := []int{2, 4, 6}
+	if err != nil {
+		t.Errorf("Expected Filter to return no error, but got %v", err)
+	}
+	if reflect.TypeOf(intSlice).Kind() != reflect.Slice {
+		t.Error("Expected Filter to return slice")
+	}
+	if !reflect.DeepEqual(intSlice, intExpected) {
+		t.Errorf("Expected Filter to return %v, but it returned %v", intExpected, intSlice)
+	}
+
+	// test for struct success
+	structSlice, err := godash.Filter([]str{{name: "first", foo: "bar"}, {name: "second"}, {name: "third", foo: "bar"}}, structFn)
+	structExpected := []str{{name: "first", foo: "bar"}, {name: "third", foo: "bar"}}
+	if err != nil {
+		t.Errorf("Expected Filter to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(structSlice, structExpected) {
+		t.Errorf("Expected Filter to return %v, but it returned %v", structExpected, structSlice)
+	}
+
+	// test for no matches
+	emptySlice, err := godash.Filter([]int{1, 3, 5}, intFn)
+	if err != nil {
+		t.Errorf("Expected Filter to return no error, but got %v", err)
+	}
+	if reflect.TypeOf(emptySlice).Kind() != reflect.Slice || reflect.ValueOf(emptySlice).Len() > 0 {
+		t.Errorf("Expected Filter to return empty slice, but got %v", emptySlice)
+	}
+
+	// test for failure
+	fail, err := godash.Filter(str{name: "one"}, structFn)
+	if err == nil {
+		t.Error("Expected Filter to return error")
+	}
+	if fail != nil {
+		t.Errorf("Expected Filter to return nil result, but got %v", fail)
+	}
+
+}
@@ -1,7 +1,6 @@
 package godash
 
 import (
-	"errors"
 	"reflect"
 )
 
@@ -11,8 +10,11 @@ import (
 func FindBy(slice interface{}, fn validator) (interface{}, error) {
 
 	sliceVal := reflect.ValueOf(slice)
-	if sliceVal.Type().Kind() != reflect.Slice {
-		return nil, errors.New("godash: invalid parameter type. FindBy func expects parameter 1 to be a slice")
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("FindBy", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("FindBy", 2)
 	}
 
 	for i := 0; i < sliceVal.Len(); i++ {
@@ -31,8 +33,11 @@ func FindBy(slice interface{}, fn validator) (interface{}, error) {
 func FindLastBy(slice interface{}, fn validator) (interface{}, error) {
 
 	sliceVal := reflect.ValueOf(slice)
-	if sliceVal.Type().Kind() != reflect.Slice {
-		return nil, errors.New("godash: invalid parameter type. FindBy func expects parameter 1 to be a slice")
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("FindLastBy", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("FindLastBy", 2)
 	}
 
 	for i := sliceVal.Len() - 1; i != -1; i-- {
@@ -46,20 +51,46 @@ func FindLastBy(slice interface{}, fn validator) (interface{}, error) {
 }
 
 // FindIndex returns the index of the first element in a slice that equals the provided value.
+// Elements are compared with reflect.DeepEqual, or with their Equal method if the element type implements Equaler.
 // If the value is not found in the slice, -1 is returned.
 func FindIndex(slice interface{}, value interface{}) (int, error) {
 
 	sliceVal := reflect.ValueOf(slice)
-	if sliceVal.Type().Kind() != reflect.Slice {
-		return -1, errors.New("godash: invalid parameter type. FindIndex func expects parameter 1 to be a slice")
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("FindIndex", 1, slice)
 	}
 
-	for i := 0; i < sliceVal.Len(); i++ {
-		if reflect.DeepEqual(sliceVal.Index(i).Interface(), value) {
-			return i, nil
-		}
+	return findIndex(sliceVal, 0, equalTo(sliceVal.Type().Elem(), value)), nil
+
+}
+
+// FindIndexFrom returns the index of the first element in a slice, at or after fromIndex, that equals the provided value.
+// A negative fromIndex is an offset from the end of the slice. If the value is not found, -1 is returned.
+func FindIndexFrom(slice interface{}, value interface{}, fromIndex int) (int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("FindIndexFrom", 1, slice)
 	}
-	return -1, nil
+
+	return findIndex(sliceVal, fromIndex, equalTo(sliceVal.Type().Elem(), value)), nil
+
+}
+
+// FindIndexWith returns the index of the first element in a slice that the provided comparator function reports equal to value.
+// The supplied function must accept two interface{} parameters, an element and the value, and return bool.
+// If the value is not found in the slice, -1 is returned.
+func FindIndexWith(slice interface{}, value interface{}, fn comparator) (int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("FindIndexWith", 1, slice)
+	}
+	if fn == nil {
+		return -1, nilFuncError("FindIndexWith", 3)
+	}
+
+	return findIndex(sliceVal, 0, func(x interface{}) bool { return fn(x, value) }), nil
 
 }
 
@@ -69,33 +100,195 @@ func FindIndex(slice interface{}, value interface{}) (int, error) {
 func FindIndexBy(slice interface{}, fn validator) (int, error) {
 
 	sliceVal := reflect.ValueOf(slice)
-	if sliceVal.Type().Kind() != reflect.Slice {
-		return -1, errors.New("godash: invalid parameter type. FindIndexBy func expects parameter 1 to be a slice")
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("FindIndexBy", 1, slice)
+	}
+	if fn == nil {
+		return -1, nilFuncError("FindIndexBy", 2)
 	}
 
-	for i := 0; i < sliceVal.Len(); i++ {
-		if match := fn(sliceVal.Index(i).Interface()); match == true {
-			return i, nil
-		}
+	return findIndex(sliceVal, 0, fn), nil
+
+}
+
+// FindIndexByFrom returns the index of the first element of a slice, at or after fromIndex, that the provided validator function returns true for.
+// The supplied function must accept an interface{} parameter and return bool.
+// A negative fromIndex is an offset from the end of the slice. If no element matches, -1 is returned.
+func FindIndexByFrom(slice interface{}, fn validator, fromIndex int) (int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("FindIndexByFrom", 1, slice)
+	}
+	if fn == nil {
+		return -1, nilFuncError("FindIndexByFrom", 2)
 	}
-	return -1, nil
+
+	return findIndex(sliceVal, fromIndex, fn), nil
 
 }
 
 // FindLastIndex returns the index of the last element in a slice that equals the provided value.
+// Elements are compared with reflect.DeepEqual, or with their Equal method if the element type implements Equaler.
 // If the value is not found in the slice, -1 is returned.
 func FindLastIndex(slice interface{}, value interface{}) (int, error) {
 
 	sliceVal := reflect.ValueOf(slice)
-	if sliceVal.Type().Kind() != reflect.Slice {
-		return -1, errors.New("godash: invalid parameter type. FindLastIndex func expects parameter 1 to be a slice")
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("FindLastIndex", 1, slice)
 	}
 
-	for i := sliceVal.Len() - 1; i != -1; i-- {
-		if reflect.DeepEqual(sliceVal.Index(i).Interface(), value) {
-			return i, nil
+	return findLastIndex(sliceVal, sliceVal.Len()-1, equalTo(sliceVal.Type().Elem(), value)), nil
+
+}
+
+// FindLastIndexFrom returns the index of the last element in a slice, at or before fromIndex, that equals the provided value.
+// A negative fromIndex is an offset from the end of the slice. If the value is not found, -1 is returned.
+func FindLastIndexFrom(slice interface{}, value interface{}, fromIndex int) (int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("FindLastIndexFrom", 1, slice)
+	}
+
+	return findLastIndex(sliceVal, fromIndex, equalTo(sliceVal.Type().Elem(), value)), nil
+
+}
+
+// FindLastIndexWith returns the index of the last element in a slice that the provided comparator function reports equal to value.
+// The supplied function must accept two interface{} parameters, an element and the value, and return bool.
+// If the value is not found in the slice, -1 is returned.
+func FindLastIndexWith(slice interface{}, value interface{}, fn comparator) (int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("FindLastIndexWith", 1, slice)
+	}
+	if fn == nil {
+		return -1, nilFuncError("FindLastIndexWith", 3)
+	}
+
+	return findLastIndex(sliceVal, sliceVal.Len()-1, func(x interface{}) bool { return fn(x, value) }), nil
+
+}
+
+// FindLastIndexBy returns the index of the last element of a slice that the provided validator function returns true for.
+// The supplied function must accept an interface{} parameter and return bool.
+// If the validator function does not return true for any values in the slice, -1 is returned.
+func FindLastIndexBy(slice interface{}, fn validator) (int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("FindLastIndexBy", 1, slice)
+	}
+	if fn == nil {
+		return -1, nilFuncError("FindLastIndexBy", 2)
+	}
+
+	return findLastIndex(sliceVal, sliceVal.Len()-1, fn), nil
+
+}
+
+// FindLastIndexByFrom returns the index of the last element of a slice, at or before fromIndex, that the provided validator function returns true for.
+// The supplied function must accept an interface{} parameter and return bool.
+// A negative fromIndex is an offset from the end of the slice. If no element matches, -1 is returned.
+func FindLastIndexByFrom(slice interface{}, fn validator, fromIndex int) (int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("FindLastIndexByFrom", 1, slice)
+	}
+	if fn == nil {
+		return -1, nilFuncError("FindLastIndexByFrom", 2)
+	}
+
+	return findLastIndex(sliceVal, fromIndex, fn), nil
+
+}
+
+// FindAllIndexes returns the indexes of all elements in a slice that equal the provided value, in ascending order.
+// If the value is not found in the slice, an empty slice is returned.
+func FindAllIndexes(slice interface{}, value interface{}) ([]int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("FindAllIndexes", 1, slice)
+	}
+
+	return findAllIndexes(sliceVal, equalTo(sliceVal.Type().Elem(), value)), nil
+
+}
+
+// FindAllIndexesBy returns the indexes of all elements of a slice that the provided validator function returns true for, in ascending order.
+// The supplied function must accept an interface{} parameter and return bool.
+// If the validator function does not return true for any values in the slice, an empty slice is returned.
+func FindAllIndexesBy(slice interface{}, fn validator) ([]int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("FindAllIndexesBy", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("FindAllIndexesBy", 2)
+	}
+
+	return findAllIndexes(sliceVal, fn), nil
+
+}
+
+// findIndex returns the index of the first element of sliceVal, at or after fromIndex, that match returns true for, or -1.
+func findIndex(sliceVal reflect.Value, fromIndex int, match func(interface{}) bool) int {
+
+	for i := fromStart(fromIndex, sliceVal.Len()); i < sliceVal.Len(); i++ {
+		if match(sliceVal.Index(i).Interface()) {
+			return i
+		}
+	}
+	return -1
+
+}
+
+// findLastIndex returns the index of the last element of sliceVal, at or before fromIndex, that match returns true for, or -1.
+// A fromIndex past the end of the slice is clamped to the last element.
+func findLastIndex(sliceVal reflect.Value, fromIndex int, match func(interface{}) bool) int {
+
+	start := fromStart(fromIndex, sliceVal.Len())
+	if start > sliceVal.Len()-1 {
+		start = sliceVal.Len() - 1
+	}
+
+	for i := start; i != -1; i-- {
+		if match(sliceVal.Index(i).Interface()) {
+			return i
+		}
+	}
+	return -1
+
+}
+
+// fromStart resolves a lodash style fromIndex for a slice of length n: negative values are an offset
+// from the end, clamped to the start of the slice.
+func fromStart(fromIndex, n int) int {
+
+	if fromIndex < 0 {
+		fromIndex += n
+		if fromIndex < 0 {
+			fromIndex = 0
+		}
+	}
+	return fromIndex
+
+}
+
+// findAllIndexes returns the indexes of all elements of sliceVal that match returns true for, in ascending order.
+func findAllIndexes(sliceVal reflect.Value, match func(interface{}) bool) []int {
+
+	indexes := []int{}
+	for i := 0; i < sliceVal.Len(); i++ {
+		if match(sliceVal.Index(i).Interface()) {
+			indexes = append(indexes, i)
 		}
 	}
-	return -1, nil
+	return indexes
 
 }
@@ -1,6 +1,7 @@
 package godash_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/zillow/godash"
@@ -166,6 +167,42 @@ func TestFindIndex(t *testing.T) {
 
 }
 
+func TestFindIndexWith(t *testing.T) {
+
+	fn := func(x, value interface{}) bool {
+		return x.(str).name == value.(str).name
+	}
+	structSource := []str{{name: "first", foo: "bar"}, {name: "second"}, {name: "first"}}
+
+	// test for success
+	index, err := godash.FindIndexWith(structSource, str{name: "first"}, fn)
+	if err != nil {
+		t.Errorf("Expected FindIndexWith to return no error, but got %v", err)
+	}
+	if index != 0 {
+		t.Errorf("Expected FindIndexWith to return %v, but it returned %v", 0, index)
+	}
+
+	// test for not found
+	index, err = godash.FindIndexWith(structSource, str{name: "third"}, fn)
+	if err != nil {
+		t.Errorf("Expected FindIndexWith to return no error, but got %v", err)
+	}
+	if index != -1 {
+		t.Errorf("Expected FindIndexWith to return %v, but it returned %v", -1, index)
+	}
+
+	// test for failure
+	index, err = godash.FindIndexWith(structSource, str{name: "first"}, nil)
+	if err == nil {
+		t.Error("Expected FindIndexWith to return error")
+	}
+	if index != -1 {
+		t.Errorf("Expected FindIndexWith to return %v, but it returned %v", -1, index)
+	}
+
+}
+
 func TestFindIndexBy(t *testing.T) {
 
 	fn := func(x interface{}) bool {
@@ -202,6 +239,93 @@ func TestFindIndexBy(t *testing.T) {
 
 }
 
+func TestFindIndexFrom(t *testing.T) {
+
+	intSource := []int{1, 2, 3, 1, 2, 3}
+
+	// test for success
+	index, err := godash.FindIndexFrom(intSource, 1, 1)
+	if err != nil {
+		t.Errorf("Expected FindIndexFrom to return no error, but got %v", err)
+	}
+	if index != 3 {
+		t.Errorf("Expected FindIndexFrom to return %v, but it returned %v", 3, index)
+	}
+
+	// test for negative offset
+	index, err = godash.FindIndexFrom(intSource, 2, -2)
+	if err != nil {
+		t.Errorf("Expected FindIndexFrom to return no error, but got %v", err)
+	}
+	if index != 4 {
+		t.Errorf("Expected FindIndexFrom to return %v, but it returned %v", 4, index)
+	}
+
+	// test for negative offset before the start
+	index, err = godash.FindIndexFrom(intSource, 1, -10)
+	if err != nil {
+		t.Errorf("Expected FindIndexFrom to return no error, but got %v", err)
+	}
+	if index != 0 {
+		t.Errorf("Expected FindIndexFrom to return %v, but it returned %v", 0, index)
+	}
+
+	// test for offset past the end
+	index, err = godash.FindIndexFrom(intSource, 1, 6)
+	if err != nil {
+		t.Errorf("Expected FindIndexFrom to return no error, but got %v", err)
+	}
+	if index != -1 {
+		t.Errorf("Expected FindIndexFrom to return %v, but it returned %v", -1, index)
+	}
+
+	// test for failure
+	index, err = godash.FindIndexFrom(1, 1, 0)
+	if err == nil {
+		t.Error("Expected FindIndexFrom to return error")
+	}
+	if index != -1 {
+		t.Errorf("Expected FindIndexFrom to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestFindIndexByFrom(t *testing.T) {
+
+	fn := func(x interface{}) bool {
+		return x.(str).name == "first"
+	}
+	structSource := []str{{name: "first"}, {name: "second"}, {name: "first"}}
+
+	// test for success
+	index, err := godash.FindIndexByFrom(structSource, fn, 1)
+	if err != nil {
+		t.Errorf("Expected FindIndexByFrom to return no error, but got %v", err)
+	}
+	if index != 2 {
+		t.Errorf("Expected FindIndexByFrom to return %v, but it returned %v", 2, index)
+	}
+
+	// test for negative offset
+	index, err = godash.FindIndexByFrom(structSource, fn, -3)
+	if err != nil {
+		t.Errorf("Expected FindIndexByFrom to return no error, but got %v", err)
+	}
+	if index != 0 {
+		t.Errorf("Expected FindIndexByFrom to return %v, but it returned %v", 0, index)
+	}
+
+	// test for failure
+	index, err = godash.FindIndexByFrom(structSource, nil, 0)
+	if err == nil {
+		t.Error("Expected FindIndexByFrom to return error")
+	}
+	if index != -1 {
+		t.Errorf("Expected FindIndexByFrom to return %v, but it returned %v", -1, index)
+	}
+
+}
+
 func TestFindLastIndex(t *testing.T) {
 
 	stringSource := []string{"one", "two", "three", "one", "two", "three"}
@@ -254,3 +378,223 @@ func TestFindLastIndex(t *testing.T) {
 	}
 
 }
+
+func TestFindLastIndexFrom(t *testing.T) {
+
+	stringSource := []string{"one", "two", "three", "one", "two", "three"}
+
+	// test for success
+	index, err := godash.FindLastIndexFrom(stringSource, "three", 4)
+	if err != nil {
+		t.Errorf("Expected FindLastIndexFrom to return no error, but got %v", err)
+	}
+	if index != 2 {
+		t.Errorf("Expected FindLastIndexFrom to return %v, but it returned %v", 2, index)
+	}
+
+	// test for negative offset
+	index, err = godash.FindLastIndexFrom(stringSource, "two", -3)
+	if err != nil {
+		t.Errorf("Expected FindLastIndexFrom to return no error, but got %v", err)
+	}
+	if index != 1 {
+		t.Errorf("Expected FindLastIndexFrom to return %v, but it returned %v", 1, index)
+	}
+
+	// test for offset past the end
+	index, err = godash.FindLastIndexFrom(stringSource, "three", 10)
+	if err != nil {
+		t.Errorf("Expected FindLastIndexFrom to return no error, but got %v", err)
+	}
+	if index != 5 {
+		t.Errorf("Expected FindLastIndexFrom to return %v, but it returned %v", 5, index)
+	}
+
+	// test for negative offset before the start
+	index, err = godash.FindLastIndexFrom(stringSource, "one", -10)
+	if err != nil {
+		t.Errorf("Expected FindLastIndexFrom to return no error, but got %v", err)
+	}
+	if index != 0 {
+		t.Errorf("Expected FindLastIndexFrom to return %v, but it returned %v", 0, index)
+	}
+
+	// test for failure
+	index, err = godash.FindLastIndexFrom(nil, "one", 0)
+	if err == nil {
+		t.Error("Expected FindLastIndexFrom to return error")
+	}
+	if index != -1 {
+		t.Errorf("Expected FindLastIndexFrom to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestFindLastIndexWith(t *testing.T) {
+
+	fn := func(x, value interface{}) bool {
+		return x.(str).name == value.(str).name
+	}
+	structSource := []str{{name: "first"}, {name: "second"}, {name: "first", foo: "bar"}}
+
+	// test for success
+	index, err := godash.FindLastIndexWith(structSource, str{name: "first"}, fn)
+	if err != nil {
+		t.Errorf("Expected FindLastIndexWith to return no error, but got %v", err)
+	}
+	if index != 2 {
+		t.Errorf("Expected FindLastIndexWith to return %v, but it returned %v", 2, index)
+	}
+
+	// test for failure
+	index, err = godash.FindLastIndexWith(str{name: "first"}, str{name: "first"}, fn)
+	if err == nil {
+		t.Error("Expected FindLastIndexWith to return error")
+	}
+	if index != -1 {
+		t.Errorf("Expected FindLastIndexWith to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestFindLastIndexBy(t *testing.T) {
+
+	fn := func(x interface{}) bool {
+		i := x.(int)
+		return i < 3
+	}
+
+	// test for success
+	index, err := godash.FindLastIndexBy([]int{1, 2, 3, 1, 5, 6}, fn)
+	if err != nil {
+		t.Errorf("Expected FindLastIndexBy to return no error, but got %v", err)
+	}
+	if index != 3 {
+		t.Errorf("Expected FindLastIndexBy to return %v, but it returned %v", 3, index)
+	}
+
+	// test for not found
+	index, err = godash.FindLastIndexBy([]int{4, 5, 6}, fn)
+	if err != nil {
+		t.Errorf("Expected FindLastIndexBy to return no error, but got %v", err)
+	}
+	if index != -1 {
+		t.Errorf("Expected FindLastIndexBy to return %v, but it returned %v", -1, index)
+	}
+
+	// test for failure
+	index, err = godash.FindLastIndexBy(5, fn)
+	if err == nil {
+		t.Error("Expected FindLastIndexBy to return error")
+	}
+	if index != -1 {
+		t.Errorf("Expected FindLastIndexBy to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestFindLastIndexByFrom(t *testing.T) {
+
+	fn := func(x interface{}) bool {
+		i := x.(int)
+		return i < 3
+	}
+	intSource := []int{1, 2, 3, 1, 5, 6}
+
+	// test for success
+	index, err := godash.FindLastIndexByFrom(intSource, fn, 2)
+	if err != nil {
+		t.Errorf("Expected FindLastIndexByFrom to return no error, but got %v", err)
+	}
+	if index != 1 {
+		t.Errorf("Expected FindLastIndexByFrom to return %v, but it returned %v", 1, index)
+	}
+
+	// test for negative offset
+	index, err = godash.FindLastIndexByFrom(intSource, fn, -2)
+	if err != nil {
+		t.Errorf("Expected FindLastIndexByFrom to return no error, but got %v", err)
+	}
+	if index != 3 {
+		t.Errorf("Expected FindLastIndexByFrom to return %v, but it returned %v", 3, index)
+	}
+
+	// test for failure
+	index, err = godash.FindLastIndexByFrom(intSource, nil, 0)
+	if err == nil {
+		t.Error("Expected FindLastIndexByFrom to return error")
+	}
+	if index != -1 {
+		t.Errorf("Expected FindLastIndexByFrom to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestFindAllIndexes(t *testing.T) {
+
+	// test for success
+	indexes, err := godash.FindAllIndexes([]str{{name: "first"}, {name: "second"}, {name: "first"}}, str{name: "first"})
+	if err != nil {
+		t.Errorf("Expected FindAllIndexes to return no error, but got %v", err)
+	}
+	expected := []int{0, 2}
+	if !reflect.DeepEqual(indexes, expected) {
+		t.Errorf("Expected FindAllIndexes to return %v, but it returned %v", expected, indexes)
+	}
+
+	// test for not found
+	indexes, err = godash.FindAllIndexes([]int{1, 2, 3}, 4)
+	if err != nil {
+		t.Errorf("Expected FindAllIndexes to return no error, but got %v", err)
+	}
+	if len(indexes) > 0 {
+		t.Errorf("Expected FindAllIndexes to return empty slice, but it returned %v", indexes)
+	}
+
+	// test for failure
+	indexes, err = godash.FindAllIndexes("one", "o")
+	if err == nil {
+		t.Error("Expected FindAllIndexes to return error")
+	}
+	if indexes != nil {
+		t.Errorf("Expected FindAllIndexes to return nil, but it returned %v", indexes)
+	}
+
+}
+
+func TestFindAllIndexesBy(t *testing.T) {
+
+	fn := func(x interface{}) bool {
+		i := x.(int)
+		return i > 2
+	}
+
+	// test for success
+	indexes, err := godash.FindAllIndexesBy([]int{1, 2, 3, 4, 1, 6}, fn)
+	expected := []int{2, 3, 5}
+	if err != nil {
+		t.Errorf("Expected FindAllIndexesBy to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(indexes, expected) {
+		t.Errorf("Expected FindAllIndexesBy to return %v, but it returned %v", expected, indexes)
+	}
+
+	// test for not found
+	indexes, err = godash.FindAllIndexesBy([]int{1, 2, 1, 2}, fn)
+	if err != nil {
+		t.Errorf("Expected FindAllIndexesBy to return no error, but got %v", err)
+	}
+	if indexes == nil || len(indexes) > 0 {
+		t.Errorf("Expected FindAllIndexesBy to return empty slice, but it returned %v", indexes)
+	}
+
+	// test for failure
+	indexes, err = godash.FindAllIndexesBy(5, fn)
+	if err == nil {
+		t.Error("Expected FindAllIndexesBy to return error")
+	}
+	if indexes != nil {
+		t.Errorf("Expected FindAllIndexesBy to return nil result, but got %v", indexes)
+	}
+
+}
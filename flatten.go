@@ -0,0 +1,91 @@
+package godash
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// Flatten flattens the provided slice a single level deep: elements that are slices, either directly or held in an interface{},
+// are replaced by their elements.
+// The element type of the new slice is the innermost element type if it is known from the type of the given slice,
+// such as int for a [][]int. Otherwise it is the type of the flattened elements if they all share the same type, or interface{} if they do not.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func Flatten(slice interface{}) (interface{}, error) {
+
+	if reflect.ValueOf(slice).Kind() != reflect.Slice {
+		return nil, notSliceError("Flatten", 1, slice)
+	}
+	return flatten(reflect.ValueOf(slice), 1), nil
+
+}
+
+// FlattenDepth flattens the provided slice up to depth levels deep, like calling Flatten depth times.
+// A depth of 0 returns a copy of the given slice. The element type of the new slice is determined like in Flatten.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func FlattenDepth(slice interface{}, depth int) (interface{}, error) {
+
+	if reflect.ValueOf(slice).Kind() != reflect.Slice {
+		return nil, notSliceError("FlattenDepth", 1, slice)
+	}
+	if depth < 0 {
+		return nil, &ArgumentError{Func: "FlattenDepth", Param: 2, Expected: "a non-negative number", Got: strconv.Itoa(depth), Err: ErrInvalidSize}
+	}
+	return flatten(reflect.ValueOf(slice), depth), nil
+
+}
+
+// FlattenDeep recursively flattens the provided slice until none of its elements are slices.
+// The element type of the new slice is determined like in Flatten.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func FlattenDeep(slice interface{}) (interface{}, error) {
+
+	if reflect.ValueOf(slice).Kind() != reflect.Slice {
+		return nil, notSliceError("FlattenDeep", 1, slice)
+	}
+	return flatten(reflect.ValueOf(slice), math.MaxInt), nil
+
+}
+
+// flatten flattens sliceVal up to depth levels deep and builds the resulting slice.
+func flatten(sliceVal reflect.Value, depth int) interface{} {
+
+	items := flattenInto([]interface{}{}, sliceVal, depth)
+
+	// unwrap the static slice types as far as the flattening goes, to find the innermost element type
+	elemType := sliceVal.Type().Elem()
+	for d := depth; d > 0 && elemType.Kind() == reflect.Slice; d-- {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() == reflect.Interface {
+		return typedSlice(items)
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(elemType), 0, len(items))
+	for _, item := range items {
+		dest = reflect.Append(dest, reflect.ValueOf(item))
+	}
+	return dest.Interface()
+
+}
+
+// flattenInto appends the elements of sliceVal to items, flattening nested slices up to depth levels deep.
+func flattenInto(items []interface{}, sliceVal reflect.Value, depth int) []interface{} {
+
+	for i := 0; i < sliceVal.Len(); i++ {
+		val := sliceVal.Index(i)
+		if val.Kind() == reflect.Interface {
+			val = val.Elem()
+		}
+		switch {
+		case depth > 0 && val.Kind() == reflect.Slice:
+			items = flattenInto(items, val, depth-1)
+		case val.IsValid():
+			items = append(items, val.Interface())
+		default:
+			items = append(items, nil)
+		}
+	}
+	return items
+
+}
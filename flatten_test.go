@@ -0,0 +1,129 @@
+package godash_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestFlatten(t *testing.T) {
+
+	// test for typed success
+	intSlice, err := godash.Flatten([][]int{{1, 2}, {}, {3}})
+	intExpected := []int{1, 2, 3}
+	if err != nil {
+		t.Errorf("Expected Flatten to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(intSlice, intExpected) {
+		t.Errorf("Expected Flatten to return %v, but it returned %v", intExpected, intSlice)
+	}
+
+	// test for single level
+	nestedSlice, err := godash.Flatten([][][]int{{{1}, {2}}, {{3}}})
+	nestedExpected := [][]int{{1}, {2}, {3}}
+	if err != nil {
+		t.Errorf("Expected Flatten to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(nestedSlice, nestedExpected) {
+		t.Errorf("Expected Flatten to return %v, but it returned %v", nestedExpected, nestedSlice)
+	}
+
+	// test for uniform interface success
+	uniformSlice, err := godash.Flatten([]interface{}{"a", []interface{}{"b", "c"}, []string{"d"}})
+	uniformExpected := []string{"a", "b", "c", "d"}
+	if err != nil {
+		t.Errorf("Expected Flatten to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(uniformSlice, uniformExpected) {
+		t.Errorf("Expected Flatten to return %v, but it returned %v", uniformExpected, uniformSlice)
+	}
+
+	// test for mixed interface success
+	mixedSlice, err := godash.Flatten([]interface{}{1, []interface{}{"b", []int{3}}, nil})
+	mixedExpected := []interface{}{1, "b", []int{3}, nil}
+	if err != nil {
+		t.Errorf("Expected Flatten to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(mixedSlice, mixedExpected) {
+		t.Errorf("Expected Flatten to return %v, but it returned %v", mixedExpected, mixedSlice)
+	}
+
+	// test for failure
+	fail, err := godash.Flatten(1)
+	if err == nil {
+		t.Error("Expected Flatten to return error")
+	}
+	if fail != nil {
+		t.Errorf("Expected Flatten to return nil result, but got %v", fail)
+	}
+
+}
+
+func TestFlattenDepth(t *testing.T) {
+
+	source := []interface{}{1, []interface{}{2, []interface{}{3, []int{4}}}}
+
+	// test for depth success
+	depthSlice, err := godash.FlattenDepth(source, 2)
+	depthExpected := []interface{}{1, 2, 3, []int{4}}
+	if err != nil {
+		t.Errorf("Expected FlattenDepth to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(depthSlice, depthExpected) {
+		t.Errorf("Expected FlattenDepth to return %v, but it returned %v", depthExpected, depthSlice)
+	}
+
+	// test for zero depth
+	copySlice, err := godash.FlattenDepth([][]int{{1}, {2}}, 0)
+	copyExpected := [][]int{{1}, {2}}
+	if err != nil {
+		t.Errorf("Expected FlattenDepth to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(copySlice, copyExpected) {
+		t.Errorf("Expected FlattenDepth to return %v, but it returned %v", copyExpected, copySlice)
+	}
+
+	// test for failure
+	fail, err := godash.FlattenDepth(source, -1)
+	if err == nil {
+		t.Error("Expected FlattenDepth to return error")
+	}
+	if fail != nil {
+		t.Errorf("Expected FlattenDepth to return nil result, but got %v", fail)
+	}
+
+}
+
+func TestFlattenDeep(t *testing.T) {
+
+	// test for uniform success
+	deepSlice, err := godash.FlattenDeep([]interface{}{1, []interface{}{2, []interface{}{3, []int{4}}}})
+	deepExpected := []int{1, 2, 3, 4}
+	if err != nil {
+		t.Errorf("Expected FlattenDeep to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(deepSlice, deepExpected) {
+		t.Errorf("Expected FlattenDeep to return %v, but it returned %v", deepExpected, deepSlice)
+	}
+
+	// test for typed success
+	typedSlice, err := godash.FlattenDeep([][][]string{{{"a"}, {"b", "c"}}, {}})
+	typedExpected := []string{"a", "b", "c"}
+	if err != nil {
+		t.Errorf("Expected FlattenDeep to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(typedSlice, typedExpected) {
+		t.Errorf("Expected FlattenDeep to return %v, but it returned %v", typedExpected, typedSlice)
+	}
+
+	// test for failure
+	fail, err := godash.FlattenDeep(nil)
+	if err == nil {
+		t.Error("Expected FlattenDeep to return error")
+	}
+	if fail != nil {
+		t.Errorf("Expected FlattenDeep to return nil result, but got %v", fail)
+	}
+
+}
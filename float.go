@@ -0,0 +1,120 @@
+package godash
+
+import (
+	"math"
+	"reflect"
+)
+
+// FindIndexApprox returns the index of the first element in a float32 or float64 slice that is within epsilon of the provided value.
+// A NaN value only matches NaN elements. If no element is close enough to the value, -1 is returned.
+// A negative or NaN epsilon is reported as an error wrapping ErrInvalidTolerance; generic.FindIndexApprox, which
+// returns no error, matches only equal values instead.
+func FindIndexApprox(slice interface{}, value float64, epsilon float64) (int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("FindIndexApprox", 1, slice)
+	}
+	if !isFloat(sliceVal.Type().Elem().Kind()) {
+		return -1, notFloatSliceError("FindIndexApprox", 1, slice)
+	}
+	if !(epsilon >= 0) {
+		return -1, invalidToleranceError("FindIndexApprox", 3, epsilon)
+	}
+
+	return findIndex(sliceVal, 0, func(x interface{}) bool {
+		return approxEqual(reflect.ValueOf(x).Float(), value, epsilon)
+	}), nil
+
+}
+
+// WithoutApprox removes the elements of a float32 or float64 slice that are within epsilon of any of the provided values and returns the new slice.
+// A NaN value removes every NaN element. A negative or NaN epsilon is reported as an error wrapping ErrInvalidTolerance;
+// generic.WithoutApprox, which returns no error, matches only equal values instead.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func WithoutApprox(slice interface{}, epsilon float64, values ...float64) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("WithoutApprox", 1, slice)
+	}
+	if !isFloat(sliceVal.Type().Elem().Kind()) {
+		return nil, notFloatSliceError("WithoutApprox", 1, slice)
+	}
+	if !(epsilon >= 0) {
+		return nil, invalidToleranceError("WithoutApprox", 2, epsilon)
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice).Elem()), 0, sliceVal.Len())
+
+	for i := 0; i < sliceVal.Len(); i++ {
+		f := sliceVal.Index(i).Float()
+		remove := false
+		for _, v := range values {
+			if approxEqual(f, v, epsilon) {
+				remove = true
+				break
+			}
+		}
+		if !remove {
+			dest = reflect.Append(dest, sliceVal.Index(i))
+		}
+	}
+	return dest.Interface(), nil
+
+}
+
+// UniqApprox removes the elements of a float32 or float64 slice that are within epsilon of an earlier element that was kept, and returns the new slice.
+// The first occurrence of each value is kept and the original order is preserved. All NaN elements are treated as duplicates of the first.
+// Because elements are compared against those already kept, the result depends on the order of the slice.
+// A negative or NaN epsilon is reported as an error wrapping ErrInvalidTolerance; generic.UniqApprox, which
+// returns no error, matches only equal values instead.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func UniqApprox(slice interface{}, epsilon float64) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("UniqApprox", 1, slice)
+	}
+	if !isFloat(sliceVal.Type().Elem().Kind()) {
+		return nil, notFloatSliceError("UniqApprox", 1, slice)
+	}
+	if !(epsilon >= 0) {
+		return nil, invalidToleranceError("UniqApprox", 2, epsilon)
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice).Elem()), 0, sliceVal.Len())
+
+	for i := 0; i < sliceVal.Len(); i++ {
+		f := sliceVal.Index(i).Float()
+		duplicate := false
+		for j := 0; j < dest.Len(); j++ {
+			if approxEqual(dest.Index(j).Float(), f, epsilon) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			dest = reflect.Append(dest, sliceVal.Index(i))
+		}
+	}
+	return dest.Interface(), nil
+
+}
+
+// approxEqual reports whether a and b differ by at most epsilon.
+// NaN is only approximately equal to NaN, and an infinity only to the same infinity.
+func approxEqual(a, b, epsilon float64) bool {
+
+	if a == b {
+		return true
+	}
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return false
+	}
+	return math.Abs(a-b) <= epsilon
+
+}
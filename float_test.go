@@ -0,0 +1,227 @@
+package godash_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestNaN(t *testing.T) {
+
+	nan := math.NaN()
+
+	// test for Uniq success
+	result, err := godash.Uniq([]float64{nan, 1, nan, 1})
+	if err != nil {
+		t.Errorf("Expected Uniq to return no error, but got %v", err)
+	}
+	if uniq := result.([]float64); len(uniq) != 2 || !math.IsNaN(uniq[0]) || uniq[1] != 1 {
+		t.Errorf("Expected Uniq to return %v, but it returned %v", []float64{nan, 1}, uniq)
+	}
+
+	// test for Intersection success
+	result, err = godash.Intersection([]float64{1, nan, 2}, []float64{nan, 2})
+	if err != nil {
+		t.Errorf("Expected Intersection to return no error, but got %v", err)
+	}
+	if intersection := result.([]float64); len(intersection) != 2 || !math.IsNaN(intersection[0]) || intersection[1] != 2 {
+		t.Errorf("Expected Intersection to return %v, but it returned %v", []float64{nan, 2}, intersection)
+	}
+
+	// test for Without success
+	result, err = godash.Without([]float64{nan, 1, nan, 2}, nan)
+	if err != nil {
+		t.Errorf("Expected Without to return no error, but got %v", err)
+	}
+	if without := result.([]float64); len(without) != 2 || without[0] != 1 || without[1] != 2 {
+		t.Errorf("Expected Without to return %v, but it returned %v", []float64{1, 2}, without)
+	}
+
+	// test for WithoutFloat32 success
+	nan32 := float32(nan)
+	without32, err := godash.WithoutFloat32([]float32{1, nan32}, nan32)
+	if err != nil {
+		t.Errorf("Expected WithoutFloat32 to return no error, but got %v", err)
+	}
+	if len(without32) != 1 || without32[0] != 1 {
+		t.Errorf("Expected WithoutFloat32 to return %v, but it returned %v", []float32{1}, without32)
+	}
+
+	// test for FindIndex success
+	index, err := godash.FindIndex([]float64{1, nan}, nan)
+	if err != nil {
+		t.Errorf("Expected FindIndex to return no error, but got %v", err)
+	}
+	if index != 1 {
+		t.Errorf("Expected FindIndex to return %v, but it returned %v", 1, index)
+	}
+
+	// test for SortedUniq success
+	result, err = godash.SortedUniq([]float64{nan, nan, 1, 1})
+	if err != nil {
+		t.Errorf("Expected SortedUniq to return no error, but got %v", err)
+	}
+	if uniq := result.([]float64); len(uniq) != 2 || !math.IsNaN(uniq[0]) || uniq[1] != 1 {
+		t.Errorf("Expected SortedUniq to return %v, but it returned %v", []float64{nan, 1}, uniq)
+	}
+
+	// test for NaN of another type
+	index, err = godash.FindIndex([]interface{}{float32(nan)}, nan)
+	if err != nil {
+		t.Errorf("Expected FindIndex to return no error, but got %v", err)
+	}
+	if index != -1 {
+		t.Errorf("Expected FindIndex to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestFindIndexApprox(t *testing.T) {
+
+	source := []float64{1, 0.1 + 0.2, math.NaN(), math.Inf(1)}
+
+	// test for success
+	index, err := godash.FindIndexApprox(source, 0.3, 1e-9)
+	if err != nil {
+		t.Errorf("Expected FindIndexApprox to return no error, but got %v", err)
+	}
+	if index != 1 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", 1, index)
+	}
+
+	// test for float32 success
+	index, err = godash.FindIndexApprox([]float32{1, 0.1}, 0.1, 1e-6)
+	if err != nil {
+		t.Errorf("Expected FindIndexApprox to return no error, but got %v", err)
+	}
+	if index != 1 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", 1, index)
+	}
+
+	// test for NaN and infinity
+	index, err = godash.FindIndexApprox(source, math.NaN(), 1e-9)
+	if err != nil {
+		t.Errorf("Expected FindIndexApprox to return no error, but got %v", err)
+	}
+	if index != 2 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", 2, index)
+	}
+	index, err = godash.FindIndexApprox(source, math.Inf(1), 1e-9)
+	if err != nil {
+		t.Errorf("Expected FindIndexApprox to return no error, but got %v", err)
+	}
+	if index != 3 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", 3, index)
+	}
+
+	// test for infinite epsilon
+	infinities := []float64{math.Inf(-1), 1, math.Inf(1)}
+	index, err = godash.FindIndexApprox(infinities, math.Inf(1), math.Inf(1))
+	if err != nil {
+		t.Errorf("Expected FindIndexApprox to return no error, but got %v", err)
+	}
+	if index != 2 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", 2, index)
+	}
+	index, err = godash.FindIndexApprox(infinities, 1e300, math.Inf(1))
+	if err != nil {
+		t.Errorf("Expected FindIndexApprox to return no error, but got %v", err)
+	}
+	if index != 1 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", 1, index)
+	}
+
+	// test for not found
+	index, err = godash.FindIndexApprox(source, 0.31, 1e-9)
+	if err != nil {
+		t.Errorf("Expected FindIndexApprox to return no error, but got %v", err)
+	}
+	if index != -1 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", -1, index)
+	}
+
+	// test for invalid epsilon
+	for _, epsilon := range []float64{-1e-9, math.NaN()} {
+		index, err = godash.FindIndexApprox(source, 0.3, epsilon)
+		if !errors.Is(err, godash.ErrInvalidTolerance) {
+			t.Errorf("Expected FindIndexApprox to return ErrInvalidTolerance, but got %v", err)
+		}
+		if index != -1 {
+			t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", -1, index)
+		}
+	}
+
+	// test for failure
+	index, err = godash.FindIndexApprox([]int{1}, 1, 0)
+	if !errors.Is(err, godash.ErrTypeMismatch) {
+		t.Errorf("Expected FindIndexApprox to return ErrTypeMismatch, but got %v", err)
+	}
+	if index != -1 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestWithoutApprox(t *testing.T) {
+
+	// test for success
+	result, err := godash.WithoutApprox([]float64{0.1 + 0.2, 1, math.NaN(), 2.0000001}, 1e-6, 0.3, 2, math.NaN())
+	if err != nil {
+		t.Errorf("Expected WithoutApprox to return no error, but got %v", err)
+	}
+	if without := result.([]float64); len(without) != 1 || without[0] != 1 {
+		t.Errorf("Expected WithoutApprox to return %v, but it returned %v", []float64{1}, without)
+	}
+
+	// test for invalid epsilon
+	result, err = godash.WithoutApprox([]float64{1}, -1, 1)
+	if !errors.Is(err, godash.ErrInvalidTolerance) {
+		t.Errorf("Expected WithoutApprox to return ErrInvalidTolerance, but got %v", err)
+	}
+	if result != nil {
+		t.Errorf("Expected WithoutApprox to return nil, but it returned %v", result)
+	}
+
+	// test for failure
+	result, err = godash.WithoutApprox(1.5, 1e-6, 1.5)
+	if !errors.Is(err, godash.ErrNotSlice) {
+		t.Errorf("Expected WithoutApprox to return ErrNotSlice, but got %v", err)
+	}
+	if result != nil {
+		t.Errorf("Expected WithoutApprox to return nil, but it returned %v", result)
+	}
+
+}
+
+func TestUniqApprox(t *testing.T) {
+
+	// test for success
+	result, err := godash.UniqApprox([]float32{1, 1.0000001, math.MaxFloat32, 2, float32(math.NaN()), 1.9999999, float32(math.NaN())}, 1e-6)
+	if err != nil {
+		t.Errorf("Expected UniqApprox to return no error, but got %v", err)
+	}
+	if uniq := result.([]float32); len(uniq) != 4 || uniq[0] != 1 || uniq[2] != 2 || !math.IsNaN(float64(uniq[3])) {
+		t.Errorf("Expected UniqApprox to return %v, but it returned %v", []float32{1, math.MaxFloat32, 2, float32(math.NaN())}, uniq)
+	}
+
+	// test for invalid epsilon
+	result, err = godash.UniqApprox([]float64{1}, math.NaN())
+	if !errors.Is(err, godash.ErrInvalidTolerance) {
+		t.Errorf("Expected UniqApprox to return ErrInvalidTolerance, but got %v", err)
+	}
+	if result != nil {
+		t.Errorf("Expected UniqApprox to return nil, but it returned %v", result)
+	}
+
+	// test for failure
+	result, err = godash.UniqApprox([]string{"1"}, 1e-6)
+	if !errors.Is(err, godash.ErrTypeMismatch) {
+		t.Errorf("Expected UniqApprox to return ErrTypeMismatch, but got %v", err)
+	}
+	if result != nil {
+		t.Errorf("Expected UniqApprox to return nil, but it returned %v", result)
+	}
+
+}
@@ -0,0 +1,62 @@
+package generic
+
+import (
+	"strconv"
+
+	"github.com/zillow/godash"
+)
+
+// Chunk splits the provided slice into consecutive chunks of the given size. The last chunk holds the remaining elements and may be shorter.
+// The chunks share the backing array of the given slice, but have their capacity limited so appending to one does not overwrite the next.
+// An error wrapping godash.ErrInvalidSize is returned if size is not positive.
+func Chunk[T any](slice []T, size int) ([][]T, error) {
+
+	if size <= 0 {
+		return nil, invalidSizeError("Chunk", 2, size)
+	}
+
+	dest := make([][]T, 0, (len(slice)+size-1)/size)
+	for i := 0; i < len(slice); i += size {
+		end := i + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		dest = append(dest, slice[i:end:end])
+	}
+	return dest, nil
+
+}
+
+// Window creates the sliding windows of the given size over the provided slice, starting a new window every step elements.
+// Only full windows are returned, so a slice shorter than size results in no windows.
+// The windows share the backing array of the given slice, but have their capacity limited so appending to one does not overwrite another.
+// An error wrapping godash.ErrInvalidSize is returned if size or step is not positive.
+func Window[T any](slice []T, size int, step int) ([][]T, error) {
+
+	if size <= 0 {
+		return nil, invalidSizeError("Window", 2, size)
+	}
+	if step <= 0 {
+		return nil, invalidSizeError("Window", 3, step)
+	}
+
+	dest := [][]T{}
+	for i := 0; i+size <= len(slice); i += step {
+		dest = append(dest, slice[i:i+size:i+size])
+	}
+	return dest, nil
+
+}
+
+// Pairwise creates a slice of the pairs of adjacent elements of the provided slice, i.e. its sliding windows of size 2.
+func Pairwise[T any](slice []T) [][]T {
+
+	dest, _ := Window(slice, 2, 1)
+	return dest
+
+}
+
+// invalidSizeError reports that parameter param of function fn, whose value was got, is not a positive size.
+func invalidSizeError(fn string, param int, got int) error {
+	return &godash.ArgumentError{Func: fn, Param: param, Expected: "a positive number", Got: strconv.Itoa(got), Err: godash.ErrInvalidSize}
+}
@@ -0,0 +1,66 @@
+package generic_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash"
+	"github.com/zillow/godash/generic"
+)
+
+func TestChunk(t *testing.T) {
+
+	// test for success
+	chunks, err := generic.Chunk([]int{1, 2, 3, 4, 5}, 2)
+	expected := [][]int{{1, 2}, {3, 4}, {5}}
+	if err != nil {
+		t.Errorf("Expected Chunk to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(chunks, expected) {
+		t.Errorf("Expected Chunk to return %v, but it returned %v", expected, chunks)
+	}
+
+	// test for failure
+	chunks, err = generic.Chunk([]int{1, 2}, 0)
+	if !errors.Is(err, godash.ErrInvalidSize) {
+		t.Errorf("Expected Chunk to return ErrInvalidSize, but got %v", err)
+	}
+	if chunks != nil {
+		t.Errorf("Expected Chunk to return nil result, but got %v", chunks)
+	}
+
+}
+
+func TestWindow(t *testing.T) {
+
+	// test for success
+	windows, err := generic.Window([]int{1, 2, 3, 4, 5, 6}, 2, 3)
+	expected := [][]int{{1, 2}, {4, 5}}
+	if err != nil {
+		t.Errorf("Expected Window to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(windows, expected) {
+		t.Errorf("Expected Window to return %v, but it returned %v", expected, windows)
+	}
+
+	// test for failure
+	windows, err = generic.Window([]int{1, 2}, 0, 1)
+	if !errors.Is(err, godash.ErrInvalidSize) {
+		t.Errorf("Expected Window to return ErrInvalidSize, but got %v", err)
+	}
+	if windows != nil {
+		t.Errorf("Expected Window to return nil result, but got %v", windows)
+	}
+
+}
+
+func TestPairwise(t *testing.T) {
+
+	pairs := generic.Pairwise([]string{"a", "b", "c"})
+	expected := [][]string{{"a", "b"}, {"b", "c"}}
+	if !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("Expected Pairwise to return %v, but it returned %v", expected, pairs)
+	}
+
+}
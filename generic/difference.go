@@ -0,0 +1,29 @@
+package generic
+
+// Difference creates a slice of the values of the first slice that are not present in the second slice.
+// The order of the items in the resulting slice is determined by the first given slice, and duplicates within it are kept.
+func Difference[T comparable](slice1 []T, slice2 []T) []T {
+
+	remove := NewSet(slice2...)
+	return WithoutBy(slice1, remove.Has)
+
+}
+
+// DifferenceBy passes items from two provided slices through a provided function and creates a new slice with the items of the first slice whose keys are not present in the second slice.
+// The order and values of the items in the resulting slice are determined by the first given slice.
+func DifferenceBy[T any, K comparable](slice1 []T, slice2 []T, fn func(T) K) []T {
+
+	dest := make([]T, 0, len(slice1))
+	m := make(map[K]bool, len(slice2))
+
+	for _, v := range slice2 {
+		m[fn(v)] = true
+	}
+	for _, v := range slice1 {
+		if !m[fn(v)] {
+			dest = append(dest, v)
+		}
+	}
+	return dest
+
+}
@@ -0,0 +1,29 @@
+package generic_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestDifference(t *testing.T) {
+
+	result := generic.Difference([]int{3, 17, 8, 3, 11}, []int{8, 5, 17})
+	expected := []int{3, 3, 11}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected Difference to return %v, but it returned %v", expected, result)
+	}
+
+}
+
+func TestDifferenceBy(t *testing.T) {
+
+	result := generic.DifferenceBy([]float64{2.16, 1.23, 5.4}, []float64{5.78, 2.49, 3.7}, math.Floor)
+	expected := []float64{1.23}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected DifferenceBy to return %v, but it returned %v", expected, result)
+	}
+
+}
@@ -0,0 +1,15 @@
+package generic
+
+// Filter creates a slice of the elements of the provided slice that the provided function returns true for.
+// The order of the items in the resulting slice is determined by the given slice.
+func Filter[T any](slice []T, fn func(T) bool) []T {
+
+	dest := make([]T, 0, len(slice))
+	for _, v := range slice {
+		if fn(v) {
+			dest = append(dest, v)
+		}
+	}
+	return dest
+
+}
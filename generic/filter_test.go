@@ -0,0 +1,18 @@
+package generic_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestFilter(t *testing.T) {
+
+	intSlice := generic.Filter([]int{1, 2, 3, 4, 5, 6}, func(i int) bool { return i%2 == 0 })
+	intExpected := []int{2, 4, 6}
+	if !reflect.DeepEqual(intSlice, intExpected) {
+		t.Errorf("Expected Filter to return %v, but it returned %v", intExpected, intSlice)
+	}
+
+}
@@ -0,0 +1,141 @@
+package generic
+
+// FindBy returns the first element of the slice that the provided function returns true for.
+// The second return value reports whether a matching element was found; if not, the zero value of T is returned.
+func FindBy[T any](slice []T, fn func(T) bool) (T, bool) {
+
+	for _, v := range slice {
+		if fn(v) {
+			return v, true
+		}
+	}
+	var zero T
+	return zero, false
+
+}
+
+// FindLastBy returns the last element of the slice that the provided function returns true for.
+// The second return value reports whether a matching element was found; if not, the zero value of T is returned.
+func FindLastBy[T any](slice []T, fn func(T) bool) (T, bool) {
+
+	for i := len(slice) - 1; i != -1; i-- {
+		if fn(slice[i]) {
+			return slice[i], true
+		}
+	}
+	var zero T
+	return zero, false
+
+}
+
+// FindIndex returns the index of the first element in a slice that equals the provided value.
+// If the value is not found in the slice, -1 is returned.
+func FindIndex[T comparable](slice []T, value T) int {
+	return FindIndexFrom(slice, value, 0)
+}
+
+// FindIndexFrom returns the index of the first element in a slice, at or after fromIndex, that equals the provided value.
+// A negative fromIndex is an offset from the end of the slice. If the value is not found, -1 is returned.
+func FindIndexFrom[T comparable](slice []T, value T, fromIndex int) int {
+	return FindIndexByFrom(slice, func(v T) bool { return sameValue(v, value) }, fromIndex)
+}
+
+// FindIndexWith returns the index of the first element in a slice that the provided function reports equal to value.
+// The function is called with an element and the value. If the value is not found in the slice, -1 is returned.
+func FindIndexWith[T any](slice []T, value T, eq func(T, T) bool) int {
+	return FindIndexBy(slice, func(v T) bool { return eq(v, value) })
+}
+
+// FindIndexBy returns the index of the first element of a slice that the provided function returns true for.
+// If the function does not return true for any values in the slice, -1 is returned.
+func FindIndexBy[T any](slice []T, fn func(T) bool) int {
+	return FindIndexByFrom(slice, fn, 0)
+}
+
+// FindIndexByFrom returns the index of the first element of a slice, at or after fromIndex, that the provided function returns true for.
+// A negative fromIndex is an offset from the end of the slice. If no element matches, -1 is returned.
+func FindIndexByFrom[T any](slice []T, fn func(T) bool, fromIndex int) int {
+
+	for i := fromStart(fromIndex, len(slice)); i < len(slice); i++ {
+		if fn(slice[i]) {
+			return i
+		}
+	}
+	return -1
+
+}
+
+// FindLastIndex returns the index of the last element in a slice that equals the provided value.
+// If the value is not found in the slice, -1 is returned.
+func FindLastIndex[T comparable](slice []T, value T) int {
+	return FindLastIndexFrom(slice, value, len(slice)-1)
+}
+
+// FindLastIndexFrom returns the index of the last element in a slice, at or before fromIndex, that equals the provided value.
+// A negative fromIndex is an offset from the end of the slice. If the value is not found, -1 is returned.
+func FindLastIndexFrom[T comparable](slice []T, value T, fromIndex int) int {
+	return FindLastIndexByFrom(slice, func(v T) bool { return sameValue(v, value) }, fromIndex)
+}
+
+// FindLastIndexWith returns the index of the last element in a slice that the provided function reports equal to value.
+// The function is called with an element and the value. If the value is not found in the slice, -1 is returned.
+func FindLastIndexWith[T any](slice []T, value T, eq func(T, T) bool) int {
+	return FindLastIndexBy(slice, func(v T) bool { return eq(v, value) })
+}
+
+// FindLastIndexBy returns the index of the last element of a slice that the provided function returns true for.
+// If the function does not return true for any values in the slice, -1 is returned.
+func FindLastIndexBy[T any](slice []T, fn func(T) bool) int {
+	return FindLastIndexByFrom(slice, fn, len(slice)-1)
+}
+
+// FindLastIndexByFrom returns the index of the last element of a slice, at or before fromIndex, that the provided function returns true for.
+// A negative fromIndex is an offset from the end of the slice. If no element matches, -1 is returned.
+func FindLastIndexByFrom[T any](slice []T, fn func(T) bool, fromIndex int) int {
+
+	start := fromStart(fromIndex, len(slice))
+	if start > len(slice)-1 {
+		start = len(slice) - 1
+	}
+	for i := start; i != -1; i-- {
+		if fn(slice[i]) {
+			return i
+		}
+	}
+	return -1
+
+}
+
+// FindAllIndexes returns the indexes of all elements in a slice that equal the provided value, in ascending order.
+// If the value is not found in the slice, an empty slice is returned.
+func FindAllIndexes[T comparable](slice []T, value T) []int {
+	return FindAllIndexesBy(slice, func(v T) bool { return sameValue(v, value) })
+}
+
+// FindAllIndexesBy returns the indexes of all elements of a slice that the provided function returns true for, in ascending order.
+// If the function does not return true for any values in the slice, an empty slice is returned.
+func FindAllIndexesBy[T any](slice []T, fn func(T) bool) []int {
+
+	indexes := []int{}
+	for i, v := range slice {
+		if fn(v) {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+
+}
+
+// fromStart resolves a lodash style fromIndex for a slice of length n: negative values are an offset
+// from the end, clamped to the start of the slice.
+func fromStart(fromIndex, n int) int {
+
+	if fromIndex < 0 {
+		fromIndex += n
+		if fromIndex < 0 {
+			fromIndex = 0
+		}
+	}
+	return fromIndex
+
+}
@@ -0,0 +1,259 @@
+package generic_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestFindBy(t *testing.T) {
+
+	intFn := func(i int) bool {
+		return i > 4
+	}
+	structFn := func(s str) bool {
+		return s.name == "second" || s.name == "third"
+	}
+
+	// test for int success
+	val, ok := generic.FindBy([]int{1, 2, 3, 4, 5, 6}, intFn)
+	if !ok {
+		t.Error("Expected FindBy to find a value")
+	}
+	if val != 5 {
+		t.Errorf("Expected FindBy to return %v, but it returned %v", 5, val)
+	}
+
+	// test for struct success
+	structVal, ok := generic.FindBy([]str{{name: "first"}, {name: "second"}, {name: "third"}}, structFn)
+	expectedStruct := str{name: "second"}
+	if !ok {
+		t.Error("Expected FindBy to find a value")
+	}
+	if structVal != expectedStruct {
+		t.Errorf("Expected FindBy to return %v, but it returned %v", expectedStruct, structVal)
+	}
+
+	// test for not found
+	val, ok = generic.FindBy([]int{1, 2, 3, 1, 2, 3}, intFn)
+	if ok {
+		t.Error("Expected FindBy to find no value")
+	}
+	if val != 0 {
+		t.Errorf("Expected FindBy to return zero value, but it returned %v", val)
+	}
+
+}
+
+func TestFindLastBy(t *testing.T) {
+
+	intFn := func(i int) bool {
+		return i < 6
+	}
+
+	// test for success
+	val, ok := generic.FindLastBy([]int{1, 2, 3, 4, 5, 6}, intFn)
+	if !ok {
+		t.Error("Expected FindLastBy to find a value")
+	}
+	if val != 5 {
+		t.Errorf("Expected FindLastBy to return %v, but it returned %v", 5, val)
+	}
+
+	// test for not found
+	val, ok = generic.FindLastBy([]int{6, 7, 8}, intFn)
+	if ok {
+		t.Error("Expected FindLastBy to find no value")
+	}
+	if val != 0 {
+		t.Errorf("Expected FindLastBy to return zero value, but it returned %v", val)
+	}
+
+}
+
+func TestFindIndex(t *testing.T) {
+
+	intSource := []int{1, 2, 3, 1, 2, 3}
+	structSource := []str{{name: "first"}, {name: "second"}, {name: "third"}}
+
+	// test for int success
+	if index := generic.FindIndex(intSource, 3); index != 2 {
+		t.Errorf("Expected FindIndex to return %v, but it returned %v", 2, index)
+	}
+
+	// test for struct success
+	if index := generic.FindIndex(structSource, str{name: "second"}); index != 1 {
+		t.Errorf("Expected FindIndex to return %v, but it returned %v", 1, index)
+	}
+
+	// test for not found
+	if index := generic.FindIndex(intSource, 8); index != -1 {
+		t.Errorf("Expected FindIndex to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestFindIndexBy(t *testing.T) {
+
+	fn := func(i int) bool {
+		return i > 4
+	}
+
+	// test for success
+	if index := generic.FindIndexBy([]int{1, 2, 3, 4, 5, 6}, fn); index != 4 {
+		t.Errorf("Expected FindIndexBy to return %v, but it returned %v", 4, index)
+	}
+
+	// test for not found
+	if index := generic.FindIndexBy([]int{1, 2, 3, 1, 2, 3}, fn); index != -1 {
+		t.Errorf("Expected FindIndexBy to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestFindLastIndex(t *testing.T) {
+
+	stringSource := []string{"one", "two", "three", "one", "two", "three"}
+
+	// test for success
+	if index := generic.FindLastIndex(stringSource, "three"); index != 5 {
+		t.Errorf("Expected FindLastIndex to return %v, but it returned %v", 5, index)
+	}
+
+	// test for not found
+	if index := generic.FindLastIndex(stringSource, "four"); index != -1 {
+		t.Errorf("Expected FindLastIndex to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestFindIndexWith(t *testing.T) {
+
+	eq := func(a, b string) bool {
+		return strings.EqualFold(a, b)
+	}
+	source := []string{"One", "two", "one"}
+
+	if index := generic.FindIndexWith(source, "ONE", eq); index != 0 {
+		t.Errorf("Expected FindIndexWith to return %v, but it returned %v", 0, index)
+	}
+	if index := generic.FindLastIndexWith(source, "ONE", eq); index != 2 {
+		t.Errorf("Expected FindLastIndexWith to return %v, but it returned %v", 2, index)
+	}
+	if index := generic.FindIndexWith(source, "three", eq); index != -1 {
+		t.Errorf("Expected FindIndexWith to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestFindIndexFrom(t *testing.T) {
+
+	intSource := []int{1, 2, 3, 1, 2, 3}
+
+	// test for success
+	if index := generic.FindIndexFrom(intSource, 1, 1); index != 3 {
+		t.Errorf("Expected FindIndexFrom to return %v, but it returned %v", 3, index)
+	}
+
+	// test for negative offset
+	if index := generic.FindIndexFrom(intSource, 2, -2); index != 4 {
+		t.Errorf("Expected FindIndexFrom to return %v, but it returned %v", 4, index)
+	}
+	if index := generic.FindIndexFrom(intSource, 1, -10); index != 0 {
+		t.Errorf("Expected FindIndexFrom to return %v, but it returned %v", 0, index)
+	}
+
+	// test for offset past the end
+	if index := generic.FindIndexFrom(intSource, 1, 6); index != -1 {
+		t.Errorf("Expected FindIndexFrom to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestFindIndexByFrom(t *testing.T) {
+
+	fn := func(i int) bool {
+		return i > 2
+	}
+
+	if index := generic.FindIndexByFrom([]int{3, 1, 4}, fn, 1); index != 2 {
+		t.Errorf("Expected FindIndexByFrom to return %v, but it returned %v", 2, index)
+	}
+
+}
+
+func TestFindLastIndexFrom(t *testing.T) {
+
+	stringSource := []string{"one", "two", "three", "one", "two", "three"}
+
+	// test for success
+	if index := generic.FindLastIndexFrom(stringSource, "three", 4); index != 2 {
+		t.Errorf("Expected FindLastIndexFrom to return %v, but it returned %v", 2, index)
+	}
+
+	// test for negative offset
+	if index := generic.FindLastIndexFrom(stringSource, "two", -3); index != 1 {
+		t.Errorf("Expected FindLastIndexFrom to return %v, but it returned %v", 1, index)
+	}
+	if index := generic.FindLastIndexFrom(stringSource, "one", -10); index != 0 {
+		t.Errorf("Expected FindLastIndexFrom to return %v, but it returned %v", 0, index)
+	}
+
+	// test for offset past the end
+	if index := generic.FindLastIndexFrom(stringSource, "three", 10); index != 5 {
+		t.Errorf("Expected FindLastIndexFrom to return %v, but it returned %v", 5, index)
+	}
+
+	// test for empty slice
+	if index := generic.FindLastIndexFrom([]string{}, "one", 3); index != -1 {
+		t.Errorf("Expected FindLastIndexFrom to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestFindLastIndexBy(t *testing.T) {
+
+	fn := func(i int) bool {
+		return i < 3
+	}
+
+	if index := generic.FindLastIndexBy([]int{1, 2, 3, 1, 5, 6}, fn); index != 3 {
+		t.Errorf("Expected FindLastIndexBy to return %v, but it returned %v", 3, index)
+	}
+	if index := generic.FindLastIndexByFrom([]int{1, 2, 3, 1, 5, 6}, fn, -4); index != 1 {
+		t.Errorf("Expected FindLastIndexByFrom to return %v, but it returned %v", 1, index)
+	}
+
+}
+
+func TestFindAllIndexes(t *testing.T) {
+
+	indexes := generic.FindAllIndexes([]string{"a", "b", "a"}, "a")
+	expected := []int{0, 2}
+	if !reflect.DeepEqual(indexes, expected) {
+		t.Errorf("Expected FindAllIndexes to return %v, but it returned %v", expected, indexes)
+	}
+
+}
+
+func TestFindAllIndexesBy(t *testing.T) {
+
+	fn := func(i int) bool {
+		return i > 2
+	}
+
+	// test for success
+	indexes := generic.FindAllIndexesBy([]int{1, 2, 3, 4, 1, 6}, fn)
+	expected := []int{2, 3, 5}
+	if !reflect.DeepEqual(indexes, expected) {
+		t.Errorf("Expected FindAllIndexesBy to return %v, but it returned %v", expected, indexes)
+	}
+
+	// test for not found
+	if indexes := generic.FindAllIndexesBy([]int{1, 2}, fn); len(indexes) > 0 {
+		t.Errorf("Expected FindAllIndexesBy to return empty slice, but it returned %v", indexes)
+	}
+
+}
@@ -0,0 +1,16 @@
+package generic
+
+// Flatten concatenates the provided slices into a single slice, flattening them one level deep.
+func Flatten[T any](slices [][]T) []T {
+
+	n := 0
+	for _, s := range slices {
+		n += len(s)
+	}
+	dest := make([]T, 0, n)
+	for _, s := range slices {
+		dest = append(dest, s...)
+	}
+	return dest
+
+}
@@ -0,0 +1,18 @@
+package generic_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestFlatten(t *testing.T) {
+
+	intSlice := generic.Flatten([][]int{{1, 2}, {}, {3}})
+	intExpected := []int{1, 2, 3}
+	if !reflect.DeepEqual(intSlice, intExpected) {
+		t.Errorf("Expected Flatten to return %v, but it returned %v", intExpected, intSlice)
+	}
+
+}
@@ -0,0 +1,44 @@
+package generic
+
+import (
+	"math"
+)
+
+// FindIndexApprox returns the index of the first element in a slice that is within epsilon of the provided value.
+// A NaN value only matches NaN elements. If no element is close enough to the value, -1 is returned.
+// A negative or NaN epsilon only matches equal values, whereas godash.FindIndexApprox reports it as an error.
+func FindIndexApprox[T ~float32 | ~float64](slice []T, value T, epsilon T) int {
+	return FindIndexBy(slice, func(v T) bool { return approxEqual(v, value, epsilon) })
+}
+
+// WithoutApprox removes the elements of a slice that are within epsilon of any of the provided values and returns the new slice.
+// A NaN value removes every NaN element.
+// A negative or NaN epsilon only matches equal values, whereas godash.WithoutApprox reports it as an error.
+func WithoutApprox[T ~float32 | ~float64](slice []T, epsilon T, values ...T) []T {
+	return WithoutWith(slice, func(a, b T) bool { return approxEqual(a, b, epsilon) }, values...)
+}
+
+// UniqApprox removes the elements of a slice that are within epsilon of an earlier element that was kept, and returns the new slice.
+// The first occurrence of each value is kept and the original order is preserved. All NaN elements are treated as duplicates of the first.
+// Because elements are compared against those already kept, the result depends on the order of the slice.
+// A negative or NaN epsilon only matches equal values, whereas godash.UniqApprox reports it as an error.
+func UniqApprox[T ~float32 | ~float64](slice []T, epsilon T) []T {
+	return UniqWith(slice, func(a, b T) bool { return approxEqual(a, b, epsilon) })
+}
+
+// approxEqual reports whether a and b differ by at most epsilon.
+// NaN is only approximately equal to NaN, and an infinity only to the same infinity.
+func approxEqual[T ~float32 | ~float64](a, b, epsilon T) bool {
+
+	if a == b {
+		return true
+	}
+	if a != a || b != b {
+		return a != a && b != b
+	}
+	if math.IsInf(float64(a), 0) || math.IsInf(float64(b), 0) {
+		return false
+	}
+	return math.Abs(float64(a-b)) <= float64(epsilon)
+
+}
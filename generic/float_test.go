@@ -0,0 +1,126 @@
+package generic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestFindIndexApprox(t *testing.T) {
+
+	source := []float64{1, 0.1 + 0.2, math.NaN()}
+
+	if index := generic.FindIndexApprox(source, 0.3, 1e-9); index != 1 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", 1, index)
+	}
+	if index := generic.FindIndexApprox(source, math.NaN(), 1e-9); index != 2 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", 2, index)
+	}
+	if index := generic.FindIndexApprox(source, 0.31, 1e-9); index != -1 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", -1, index)
+	}
+
+	// test for infinite epsilon
+	infinities := []float64{math.Inf(-1), 1, math.Inf(1)}
+	if index := generic.FindIndexApprox(infinities, math.Inf(1), math.Inf(1)); index != 2 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", 2, index)
+	}
+	if index := generic.FindIndexApprox(infinities, 1e300, math.Inf(1)); index != 1 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", 1, index)
+	}
+
+	// test for invalid epsilon
+	if index := generic.FindIndexApprox(source, 0.1+0.2, -1); index != 1 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", 1, index)
+	}
+	if index := generic.FindIndexApprox(source, 0.30001, math.NaN()); index != -1 {
+		t.Errorf("Expected FindIndexApprox to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestWithoutApprox(t *testing.T) {
+
+	result := generic.WithoutApprox([]float32{0.1 + 0.2, 1, float32(math.NaN())}, 1e-6, 0.3, float32(math.NaN()))
+	if len(result) != 1 || result[0] != 1 {
+		t.Errorf("Expected WithoutApprox to return %v, but it returned %v", []float32{1}, result)
+	}
+
+}
+
+func TestUniqApprox(t *testing.T) {
+
+	result := generic.UniqApprox([]float64{1, 1.0000001, 2, math.NaN(), math.NaN()}, 1e-6)
+	if len(result) != 3 || result[0] != 1 || result[1] != 2 || !math.IsNaN(result[2]) {
+		t.Errorf("Expected UniqApprox to return %v, but it returned %v", []float64{1, 2, math.NaN()}, result)
+	}
+
+}
+
+func TestNaN(t *testing.T) {
+
+	nan := math.NaN()
+
+	// test for Uniq success
+	if result := generic.Uniq([]float64{nan, 1, nan}); len(result) != 2 || !math.IsNaN(result[0]) || result[1] != 1 {
+		t.Errorf("Expected Uniq to return %v, but it returned %v", []float64{nan, 1}, result)
+	}
+
+	// test for FindIndex and Includes success
+	source := []float64{1, nan, 2, nan}
+	if index := generic.FindIndex(source, nan); index != 1 {
+		t.Errorf("Expected FindIndex to return %v, but it returned %v", 1, index)
+	}
+	if index := generic.FindLastIndex(source, nan); index != 3 {
+		t.Errorf("Expected FindLastIndex to return %v, but it returned %v", 3, index)
+	}
+	if indexes := generic.FindAllIndexes(source, nan); len(indexes) != 2 || indexes[0] != 1 || indexes[1] != 3 {
+		t.Errorf("Expected FindAllIndexes to return %v, but it returned %v", []int{1, 3}, indexes)
+	}
+	if !generic.Includes(source, nan) {
+		t.Error("Expected Includes to return true")
+	}
+
+	// test for SortedUniq success
+	if result := generic.SortedUniq([]float64{nan, nan, 1, 1}); len(result) != 2 || !math.IsNaN(result[0]) || result[1] != 1 {
+		t.Errorf("Expected SortedUniq to return %v, but it returned %v", []float64{nan, 1}, result)
+	}
+
+	// test for Difference, Xor and IntersectionN success
+	if result := generic.Difference([]float64{nan, 1, nan}, []float64{nan}); len(result) != 1 || result[0] != 1 {
+		t.Errorf("Expected Difference to return %v, but it returned %v", []float64{1}, result)
+	}
+	if result := generic.Xor([]float64{nan, 1}, []float64{nan, 2}); len(result) != 2 || result[0] != 1 || result[1] != 2 {
+		t.Errorf("Expected Xor to return %v, but it returned %v", []float64{1, 2}, result)
+	}
+	if result := generic.IntersectionN([]float64{1, nan}, []float64{nan}, []float64{nan, 1}); len(result) != 1 || !math.IsNaN(result[0]) {
+		t.Errorf("Expected IntersectionN to return %v, but it returned %v", []float64{nan}, result)
+	}
+
+	// test for Without success
+	if result := generic.Without([]float64{nan, 1, nan}, nan); len(result) != 1 || result[0] != 1 {
+		t.Errorf("Expected Without to return %v, but it returned %v", []float64{1}, result)
+	}
+
+	// test for Set success
+	s := generic.NewSet(1, nan, 2)
+	if !s.Has(nan) {
+		t.Error("Expected Has to return true")
+	}
+	if s.Len() != 3 {
+		t.Errorf("Expected Len to return %v, but it returned %v", 3, s.Len())
+	}
+	s.Remove(nan, 1)
+	if s.Has(nan) || s.Len() != 1 {
+		t.Errorf("Expected Set to hold %v, but it holds %v", []float64{2}, s.ToSlice())
+	}
+	s.Add(nan)
+	if result := s.ToSlice(); len(result) != 2 || result[0] != 2 || !math.IsNaN(result[1]) {
+		t.Errorf("Expected ToSlice to return %v, but it returned %v", []float64{2, nan}, result)
+	}
+	if !generic.NewSet(nan).IsSubset(s) || s.IsSubset(generic.NewSet(2.0, 3.0)) {
+		t.Error("Expected IsSubset to treat NaN as a member")
+	}
+
+}
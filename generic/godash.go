@@ -0,0 +1,13 @@
+// Package generic provides type-safe counterparts of the godash functions built on Go generics.
+// Unlike the reflection-based functions in the parent package, these functions are checked at
+// compile time, return correctly typed results and accept typed callbacks, so no type assertions are needed.
+//
+// The Set type can be used to keep a collection of unique values around for repeated membership checks.
+//
+// Values are compared with ==, so the godash Hasher and Equaler interfaces are not used here. To compare values by a
+// canonical key or a custom equality, use the ...By and ...With functions, for example generic.UniqBy(s, T.HashKey).
+//
+// Float NaN values are never equal to themselves under ==. The functions that compare values rather than keys, such as
+// FindIndex, Includes, Uniq, SortedUniq, Intersection, Union, Difference, Xor, Without, Pull and the Set type, treat all
+// NaNs as equal instead, matching the godash package. Keys returned to the ...By functions are compared with == only.
+package generic
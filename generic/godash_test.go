@@ -0,0 +1,6 @@
+package generic_test
+
+type str struct {
+	name string
+	foo  string
+}
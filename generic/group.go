@@ -0,0 +1,53 @@
+package generic
+
+import (
+	"fmt"
+
+	"github.com/zillow/godash"
+)
+
+// GroupBy passes every element of the provided slice through a provided function and groups the elements by the resulting keys.
+// The result is a map from each key to a slice of the elements that resulted in it, in their original order.
+func GroupBy[T any, K comparable](slice []T, fn func(T) K) map[K][]T {
+
+	dest := make(map[K][]T)
+	for _, v := range slice {
+		key := fn(v)
+		dest[key] = append(dest[key], v)
+	}
+	return dest
+
+}
+
+// KeyBy passes every element of the provided slice through a provided function and creates a map from the resulting keys to the elements.
+// When several elements result in the same key, onConflict determines whether the last or first of them is kept,
+// or whether an error wrapping godash.ErrDuplicateKey is returned.
+func KeyBy[T any, K comparable](slice []T, fn func(T) K, onConflict godash.KeyConflict) (map[K]T, error) {
+
+	dest := make(map[K]T, len(slice))
+	for _, v := range slice {
+		key := fn(v)
+		if _, exists := dest[key]; exists {
+			if onConflict == godash.KeepFirst {
+				continue
+			}
+			if onConflict == godash.RejectConflict {
+				return nil, fmt.Errorf("godash: KeyBy func found key %v for more than one element: %w", key, godash.ErrDuplicateKey)
+			}
+		}
+		dest[key] = v
+	}
+	return dest, nil
+
+}
+
+// CountBy passes every element of the provided slice through a provided function and counts how many elements resulted in each key.
+func CountBy[T any, K comparable](slice []T, fn func(T) K) map[K]int {
+
+	dest := make(map[K]int)
+	for _, v := range slice {
+		dest[fn(v)]++
+	}
+	return dest
+
+}
@@ -0,0 +1,68 @@
+package generic_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash"
+	"github.com/zillow/godash/generic"
+)
+
+func TestGroupBy(t *testing.T) {
+
+	source := []str{{name: "apple", foo: "1"}, {name: "orange", foo: "2"}, {name: "apple", foo: "3"}}
+	groups := generic.GroupBy(source, func(s str) string { return s.name })
+	expected := map[string][]str{
+		"apple":  {{name: "apple", foo: "1"}, {name: "apple", foo: "3"}},
+		"orange": {{name: "orange", foo: "2"}},
+	}
+	if !reflect.DeepEqual(groups, expected) {
+		t.Errorf("Expected GroupBy to return %v, but it returned %v", expected, groups)
+	}
+
+}
+
+func TestKeyBy(t *testing.T) {
+
+	fn := func(s str) string { return s.name }
+	source := []str{{name: "apple", foo: "1"}, {name: "orange", foo: "2"}, {name: "apple", foo: "3"}}
+
+	// test for last wins
+	keyed, err := generic.KeyBy(source, fn, godash.KeepLast)
+	if err != nil {
+		t.Errorf("Expected KeyBy to return no error, but got %v", err)
+	}
+	if keyed["apple"].foo != "3" {
+		t.Errorf("Expected KeyBy to keep the last element, but it kept %v", keyed["apple"])
+	}
+
+	// test for first wins
+	keyed, err = generic.KeyBy(source, fn, godash.KeepFirst)
+	if err != nil {
+		t.Errorf("Expected KeyBy to return no error, but got %v", err)
+	}
+	if keyed["apple"].foo != "1" {
+		t.Errorf("Expected KeyBy to keep the first element, but it kept %v", keyed["apple"])
+	}
+
+	// test for conflict error
+	keyed, err = generic.KeyBy(source, fn, godash.RejectConflict)
+	if !errors.Is(err, godash.ErrDuplicateKey) {
+		t.Errorf("Expected KeyBy to return ErrDuplicateKey, but got %v", err)
+	}
+	if keyed != nil {
+		t.Errorf("Expected KeyBy to return nil result, but got %v", keyed)
+	}
+
+}
+
+func TestCountBy(t *testing.T) {
+
+	counts := generic.CountBy([]float64{1.2, 2.5, 1.7, 3.1}, func(f float64) int { return int(f) })
+	expected := map[int]int{1: 2, 2: 1, 3: 1}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("Expected CountBy to return %v, but it returned %v", expected, counts)
+	}
+
+}
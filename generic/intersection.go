@@ -0,0 +1,75 @@
+package generic
+
+// Intersection creates a slice of unique values that were present in both of the provided slices.
+// The order of the items in the resulting slice is determined by the first given slice.
+func Intersection[T comparable](slice1 []T, slice2 []T) []T {
+	return NewSet(slice1...).Intersect(NewSet(slice2...)).ToSlice()
+}
+
+// IntersectionBy passes items from two provided slices through a provided function and creates a new slice with items that resulted in common keys.
+// The order and values of the items in the resulting slice are determined by the first given slice.
+func IntersectionBy[T any, K comparable](slice1 []T, slice2 []T, fn func(T) K) []T {
+
+	dest := make([]T, 0, len(slice1))
+	m := make(map[K]bool, len(slice2))
+
+	for _, v := range slice2 {
+		m[fn(v)] = false
+	}
+	for _, v := range slice1 {
+		key := fn(v)
+		appended, exists := m[key]
+		if exists && !appended {
+			dest = append(dest, v)
+			m[key] = true
+		}
+	}
+	return dest
+
+}
+
+// IntersectionN creates a slice of unique values that were present in all of the provided slices.
+// The order of the items in the resulting slice is determined by the first given slice.
+func IntersectionN[T comparable](slices ...[]T) []T {
+
+	if len(slices) == 0 {
+		return []T{}
+	}
+	s := NewSet(slices[0]...)
+	for _, slice := range slices[1:] {
+		s = s.Intersect(NewSet(slice...))
+	}
+	return s.ToSlice()
+
+}
+
+// IntersectionNBy passes items from all of the provided slices through a provided function and creates a new slice with items that resulted in keys common to every slice.
+// The order and values of the items in the resulting slice are determined by the first given slice.
+func IntersectionNBy[T any, K comparable](fn func(T) K, slices ...[]T) []T {
+
+	if len(slices) == 0 {
+		return []T{}
+	}
+
+	// counts records how many of the subsequent slices, taken in order, each key has been seen in
+	counts := make(map[K]int, len(slices[0]))
+	for i := 1; i < len(slices); i++ {
+		for _, v := range slices[i] {
+			key := fn(v)
+			if counts[key] == i-1 {
+				counts[key] = i
+			}
+		}
+	}
+
+	dest := make([]T, 0, len(slices[0]))
+	for _, v := range slices[0] {
+		key := fn(v)
+		if counts[key] == len(slices)-1 {
+			dest = append(dest, v)
+			counts[key] = len(slices)
+		}
+	}
+	return dest
+
+}
@@ -0,0 +1,70 @@
+package generic_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestIntersection(t *testing.T) {
+
+	// test int success
+	intSlice := generic.Intersection([]int{3, 17, 8, 11, 4, 8}, []int{11, 8, 5})
+	intExpected := []int{8, 11}
+	if !reflect.DeepEqual(intSlice, intExpected) {
+		t.Errorf("Expected Intersection to return %v, but it returned %v", intExpected, intSlice)
+	}
+
+	// test struct success
+	structSlice := generic.Intersection([]str{{name: "first"}, {name: "second"}, {name: "third"}}, []str{{name: "second"}, {name: "third"}, {name: "fourth"}})
+	structExpected := []str{{name: "second"}, {name: "third"}}
+	if !reflect.DeepEqual(structSlice, structExpected) {
+		t.Errorf("Expected Intersection to return %v, but it returned %v", structExpected, structSlice)
+	}
+
+}
+
+func TestIntersectionBy(t *testing.T) {
+
+	// test float success
+	floatSlice := generic.IntersectionBy([]float64{2.16, 1.23, 5.4}, []float64{5.78, 2.49, 3.7, 2.3}, math.Floor)
+	floatExpected := []float64{2.16, 5.4}
+	if !reflect.DeepEqual(floatSlice, floatExpected) {
+		t.Errorf("Expected IntersectionBy to return %v, but it returned %v", floatExpected, floatSlice)
+	}
+
+	// test struct success
+	fn := func(s str) string {
+		return s.name
+	}
+	str1 := []str{{name: "apple", foo: "bar"}, {name: "orange", foo: "bar"}, {name: "apple", foo: ""}}
+	str2 := []str{{name: "banana", foo: "barz"}, {name: "apple", foo: "barz"}}
+	structSlice := generic.IntersectionBy(str1, str2, fn)
+	structExpected := []str{{name: "apple", foo: "bar"}}
+	if !reflect.DeepEqual(structSlice, structExpected) {
+		t.Errorf("Expected IntersectionBy to return %v, but it returned %v", structExpected, structSlice)
+	}
+
+}
+
+func TestIntersectionN(t *testing.T) {
+
+	intSlice := generic.IntersectionN([]int{3, 17, 8, 11, 4, 8}, []int{11, 8, 5, 4}, []int{4, 8, 8}, []int{8, 1, 4})
+	intExpected := []int{8, 4}
+	if !reflect.DeepEqual(intSlice, intExpected) {
+		t.Errorf("Expected IntersectionN to return %v, but it returned %v", intExpected, intSlice)
+	}
+
+}
+
+func TestIntersectionNBy(t *testing.T) {
+
+	floatSlice := generic.IntersectionNBy(math.Floor, []float64{2.16, 1.23, 5.4}, []float64{5.78, 2.49, 3.7}, []float64{2.3, 5.1})
+	floatExpected := []float64{2.16, 5.4}
+	if !reflect.DeepEqual(floatSlice, floatExpected) {
+		t.Errorf("Expected IntersectionNBy to return %v, but it returned %v", floatExpected, floatSlice)
+	}
+
+}
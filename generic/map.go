@@ -0,0 +1,23 @@
+package generic
+
+// Map creates a new slice with the results of passing every element of the provided slice through a provided function.
+func Map[T any, U any](slice []T, fn func(T) U) []U {
+
+	dest := make([]U, 0, len(slice))
+	for _, v := range slice {
+		dest = append(dest, fn(v))
+	}
+	return dest
+
+}
+
+// FlatMap creates a new slice by passing every element of the provided slice through a provided function and concatenating the resulting slices.
+func FlatMap[T any, U any](slice []T, fn func(T) []U) []U {
+
+	dest := make([]U, 0, len(slice))
+	for _, v := range slice {
+		dest = append(dest, fn(v)...)
+	}
+	return dest
+
+}
@@ -0,0 +1,30 @@
+package generic_test
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestMap(t *testing.T) {
+
+	stringSlice := generic.Map([]int{1, 2, 3}, strconv.Itoa)
+	stringExpected := []string{"1", "2", "3"}
+	if !reflect.DeepEqual(stringSlice, stringExpected) {
+		t.Errorf("Expected Map to return %v, but it returned %v", stringExpected, stringSlice)
+	}
+
+}
+
+func TestFlatMap(t *testing.T) {
+
+	wordSlice := generic.FlatMap([]string{"a b", "c", ""}, strings.Fields)
+	wordExpected := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(wordSlice, wordExpected) {
+		t.Errorf("Expected FlatMap to return %v, but it returned %v", wordExpected, wordSlice)
+	}
+
+}
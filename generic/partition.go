@@ -0,0 +1,18 @@
+package generic
+
+// Partition splits the provided slice into the elements that the provided function returns true for and the elements it returns false for.
+// The function is called once per element, and both new slices keep the order of the given slice.
+func Partition[T any](slice []T, fn func(T) bool) ([]T, []T) {
+
+	matched := make([]T, 0, len(slice))
+	rest := make([]T, 0, len(slice))
+	for _, v := range slice {
+		if fn(v) {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matched, rest
+
+}
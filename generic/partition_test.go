@@ -0,0 +1,22 @@
+package generic_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestPartition(t *testing.T) {
+
+	matched, rest := generic.Partition([]int{1, 2, 3, 4, 5}, func(i int) bool { return i%2 == 0 })
+	matchedExpected := []int{2, 4}
+	restExpected := []int{1, 3, 5}
+	if !reflect.DeepEqual(matched, matchedExpected) {
+		t.Errorf("Expected Partition to return matched %v, but it returned %v", matchedExpected, matched)
+	}
+	if !reflect.DeepEqual(rest, restExpected) {
+		t.Errorf("Expected Partition to return rest %v, but it returned %v", restExpected, rest)
+	}
+
+}
@@ -0,0 +1,31 @@
+package generic
+
+// Every reports whether the provided function returns true for every element of the slice.
+// It stops at the first element the function returns false for. An empty slice results in true.
+func Every[T any](slice []T, fn func(T) bool) bool {
+
+	for _, v := range slice {
+		if !fn(v) {
+			return false
+		}
+	}
+	return true
+
+}
+
+// Some reports whether the provided function returns true for at least one element of the slice.
+// It stops at the first element the function returns true for. An empty slice results in false.
+func Some[T any](slice []T, fn func(T) bool) bool {
+	return FindIndexBy(slice, fn) != -1
+}
+
+// None reports whether the provided function returns false for every element of the slice.
+// It stops at the first element the function returns true for. An empty slice results in true.
+func None[T any](slice []T, fn func(T) bool) bool {
+	return FindIndexBy(slice, fn) == -1
+}
+
+// Includes reports whether the slice holds an element that equals the provided value.
+func Includes[T comparable](slice []T, value T) bool {
+	return FindIndex(slice, value) != -1
+}
@@ -0,0 +1,54 @@
+package generic_test
+
+import (
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestEvery(t *testing.T) {
+
+	positive := func(i int) bool { return i > 0 }
+	if !generic.Every([]int{1, 2, 3}, positive) {
+		t.Error("Expected Every to return true")
+	}
+	if generic.Every([]int{1, -2, 3}, positive) {
+		t.Error("Expected Every to return false")
+	}
+
+}
+
+func TestSome(t *testing.T) {
+
+	negative := func(i int) bool { return i < 0 }
+	if !generic.Some([]int{1, -2, 3}, negative) {
+		t.Error("Expected Some to return true")
+	}
+	if generic.Some([]int{1, 2, 3}, negative) {
+		t.Error("Expected Some to return false")
+	}
+
+}
+
+func TestNone(t *testing.T) {
+
+	negative := func(i int) bool { return i < 0 }
+	if !generic.None([]int{1, 2, 3}, negative) {
+		t.Error("Expected None to return true")
+	}
+	if generic.None([]int{1, -2, 3}, negative) {
+		t.Error("Expected None to return false")
+	}
+
+}
+
+func TestIncludes(t *testing.T) {
+
+	if !generic.Includes([]string{"a", "b"}, "b") {
+		t.Error("Expected Includes to return true")
+	}
+	if generic.Includes([]string{"a", "b"}, "c") {
+		t.Error("Expected Includes to return false")
+	}
+
+}
@@ -0,0 +1,38 @@
+package generic
+
+// Pull removes values from the slice that slice points to, modifying it in place, and returns the removed elements.
+// Values are compared like in Without. The remaining elements are compacted into the existing backing array, keeping their order.
+func Pull[T comparable](slice *[]T, values ...T) []T {
+
+	remove := NewSet(values...)
+	return Remove(slice, remove.Has)
+
+}
+
+// Remove removes the elements for which the provided function returns true from the slice that slice points to,
+// modifying it in place, and returns the removed elements.
+// The remaining elements are compacted into the existing backing array, keeping their order.
+func Remove[T any](slice *[]T, fn func(T) bool) []T {
+
+	s := *slice
+	removed := []T{}
+	n := 0
+
+	for _, v := range s {
+		if fn(v) {
+			removed = append(removed, v)
+			continue
+		}
+		s[n] = v
+		n++
+	}
+
+	// clear the vacated tail so removed elements are not kept alive by the backing array
+	var zero T
+	for i := n; i < len(s); i++ {
+		s[i] = zero
+	}
+	*slice = s[:n]
+	return removed
+
+}
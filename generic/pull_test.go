@@ -0,0 +1,42 @@
+package generic_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestPull(t *testing.T) {
+
+	source := []string{"one", "two", "three", "two"}
+	removed := generic.Pull(&source, "two")
+	expected := []string{"one", "three"}
+	removedExpected := []string{"two", "two"}
+	if !reflect.DeepEqual(source, expected) {
+		t.Errorf("Expected Pull to leave %v, but it left %v", expected, source)
+	}
+	if !reflect.DeepEqual(removed, removedExpected) {
+		t.Errorf("Expected Pull to return %v, but it returned %v", removedExpected, removed)
+	}
+
+}
+
+func TestRemove(t *testing.T) {
+
+	source := []int{1, 2, 3, 4, 5, 6}
+	full := source[:cap(source)]
+	removed := generic.Remove(&source, func(i int) bool { return i%2 == 0 })
+	expected := []int{1, 3, 5}
+	removedExpected := []int{2, 4, 6}
+	if !reflect.DeepEqual(source, expected) {
+		t.Errorf("Expected Remove to leave %v, but it left %v", expected, source)
+	}
+	if !reflect.DeepEqual(removed, removedExpected) {
+		t.Errorf("Expected Remove to return %v, but it returned %v", removedExpected, removed)
+	}
+	if !reflect.DeepEqual(full, []int{1, 3, 5, 0, 0, 0}) {
+		t.Errorf("Expected Remove to compact in place and clear the tail, but backing array is %v", full)
+	}
+
+}
@@ -0,0 +1,39 @@
+package generic
+
+// Reduce combines the elements of the slice into a single value by calling the provided function for each element from first to last.
+// The first call receives initial as the accumulated value. If the slice is empty, initial is returned.
+func Reduce[T any, A any](slice []T, fn func(A, T) A, initial A) A {
+
+	acc := initial
+	for _, v := range slice {
+		acc = fn(acc, v)
+	}
+	return acc
+
+}
+
+// ReduceRight is like Reduce, except that it visits the elements of the slice from last to first.
+func ReduceRight[T any, A any](slice []T, fn func(A, T) A, initial A) A {
+
+	acc := initial
+	for i := len(slice) - 1; i != -1; i-- {
+		acc = fn(acc, slice[i])
+	}
+	return acc
+
+}
+
+// Fold is like Reduce, except that the provided function can stop the iteration early by returning true
+// along with the accumulated value, which then becomes the result.
+func Fold[T any, A any](slice []T, fn func(A, T) (A, bool), initial A) A {
+
+	acc := initial
+	for _, v := range slice {
+		var stop bool
+		if acc, stop = fn(acc, v); stop {
+			break
+		}
+	}
+	return acc
+
+}
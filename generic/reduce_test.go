@@ -0,0 +1,41 @@
+package generic_test
+
+import (
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestReduce(t *testing.T) {
+
+	sum := generic.Reduce([]int{1, 2, 3, 4}, func(acc int, x int) int { return acc + x }, 10)
+	if sum != 20 {
+		t.Errorf("Expected Reduce to return %v, but it returned %v", 20, sum)
+	}
+
+}
+
+func TestReduceRight(t *testing.T) {
+
+	result := generic.ReduceRight([]string{"a", "b", "c"}, func(acc string, x string) string { return acc + x }, "")
+	if result != "cba" {
+		t.Errorf("Expected ReduceRight to return %v, but it returned %v", "cba", result)
+	}
+
+}
+
+func TestFold(t *testing.T) {
+
+	calls := 0
+	sum := generic.Fold([]int{1, 2, 3, 4, 5}, func(acc int, x int) (int, bool) {
+		calls++
+		return acc + x, acc+x > 5
+	}, 0)
+	if sum != 6 {
+		t.Errorf("Expected Fold to return %v, but it returned %v", 6, sum)
+	}
+	if calls != 3 {
+		t.Errorf("Expected Fold to stop after %v calls, but it made %v", 3, calls)
+	}
+
+}
@@ -0,0 +1,223 @@
+package generic
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Set is a collection of unique comparable values that remembers insertion order.
+// ToSlice and JSON marshaling return the members in the order they were first added.
+// Float NaN values, which never equal themselves, are all treated as the same member.
+// Other values that never equal themselves, such as structs or arrays holding a NaN, are kept as distinct members
+// that Has never reports and Remove cannot remove, like keys of a map.
+// The zero value is an empty set ready to use.
+type Set[T comparable] struct {
+	index map[T]int // position of each member in items
+	items []T
+	alive []bool // whether each slot of items holds a member rather than a removed value
+	size  int    // number of members
+	nan   int    // position of the NaN member in items plus one, or zero if NaN is not a member
+}
+
+// NewSet creates a set containing the provided values.
+func NewSet[T comparable](values ...T) *Set[T] {
+
+	s := &Set[T]{index: make(map[T]int, len(values)), items: make([]T, 0, len(values)), alive: make([]bool, 0, len(values))}
+	s.Add(values...)
+	return s
+
+}
+
+// Add adds the provided values to the set. Values that are already members keep their original position.
+func (s *Set[T]) Add(values ...T) {
+
+	if s.index == nil {
+		s.index = make(map[T]int, len(values))
+	}
+	for _, v := range values {
+		switch {
+		case v != v:
+			// NaN is a single member, while other values that never equal themselves cannot be looked up
+			if isNaN(v) {
+				if s.nan != 0 {
+					continue
+				}
+				s.nan = len(s.items) + 1
+			}
+		default:
+			if _, ok := s.index[v]; ok {
+				continue
+			}
+			s.index[v] = len(s.items)
+		}
+		s.items = append(s.items, v)
+		s.alive = append(s.alive, true)
+		s.size++
+	}
+
+}
+
+// Remove removes the provided values from the set. Values that are not members are ignored.
+func (s *Set[T]) Remove(values ...T) {
+
+	var zero T
+	for _, v := range values {
+		i := -1
+		if isNaN(v) {
+			i = s.nan - 1
+			s.nan = 0
+		} else if j, ok := s.index[v]; ok {
+			i = j
+			delete(s.index, v)
+		}
+		if i == -1 {
+			continue
+		}
+		s.items[i] = zero
+		s.alive[i] = false
+		s.size--
+	}
+	if len(s.items)-s.size > len(s.items)/2 {
+		s.compact()
+	}
+
+}
+
+// Has reports whether v is a member of the set.
+func (s *Set[T]) Has(v T) bool {
+
+	if isNaN(v) {
+		return s.nan != 0
+	}
+	_, ok := s.index[v]
+	return ok
+
+}
+
+// Len returns the number of members of the set.
+func (s *Set[T]) Len() int {
+	return s.size
+}
+
+// Union returns a new set with the members of both sets, those of s first.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+
+	dest := NewSet(s.ToSlice()...)
+	dest.Add(other.ToSlice()...)
+	return dest
+
+}
+
+// Intersect returns a new set with the members of s that are also members of other, in the order of s.
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+
+	dest := NewSet[T]()
+	for _, v := range s.ToSlice() {
+		if other.Has(v) {
+			dest.Add(v)
+		}
+	}
+	return dest
+
+}
+
+// Difference returns a new set with the members of s that are not members of other, in the order of s.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+
+	dest := NewSet[T]()
+	for _, v := range s.ToSlice() {
+		if !other.Has(v) {
+			dest.Add(v)
+		}
+	}
+	return dest
+
+}
+
+// IsSubset reports whether every member of s is also a member of other.
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+
+	if s.Len() > other.Len() {
+		return false
+	}
+	for i, v := range s.items {
+		if s.alive[i] && !other.Has(v) {
+			return false
+		}
+	}
+	return true
+
+}
+
+// ToSlice returns the members of the set in insertion order.
+func (s *Set[T]) ToSlice() []T {
+
+	dest := make([]T, 0, s.Len())
+	for i, v := range s.items {
+		if s.alive[i] {
+			dest = append(dest, v)
+		}
+	}
+	return dest
+
+}
+
+// MarshalJSON encodes the set as a JSON array of its members in insertion order.
+// It has a value receiver so that sets held by value, such as struct fields, are encoded as arrays too.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON replaces the members of the set with the values of a JSON array.
+// Duplicate values in the array are only added once.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*s = Set[T]{}
+	s.Add(values...)
+	return nil
+
+}
+
+// compact drops the slots left behind by Remove and reindexes the remaining members.
+func (s *Set[T]) compact() {
+
+	items := make([]T, 0, s.size)
+	for i, v := range s.items {
+		if !s.alive[i] {
+			continue
+		}
+		switch {
+		case s.nan == i+1:
+			s.nan = len(items) + 1
+		case v == v:
+			s.index[v] = len(items)
+		}
+		items = append(items, v)
+	}
+	s.items = items
+	s.alive = s.alive[:len(items)]
+	for i := range s.alive {
+		s.alive[i] = true
+	}
+
+}
+
+// sameValue reports whether a and b are equal, treating all float NaNs as equal.
+func sameValue[T comparable](a, b T) bool {
+	return a == b || isNaN(a) && isNaN(b)
+}
+
+// isNaN reports whether v is a float NaN. Reflection is only used for values that do not equal themselves.
+func isNaN[T comparable](v T) bool {
+
+	if v == v {
+		return false
+	}
+	k := reflect.ValueOf(v).Kind()
+	return k == reflect.Float32 || k == reflect.Float64
+
+}
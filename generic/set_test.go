@@ -0,0 +1,185 @@
+package generic_test
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestSet(t *testing.T) {
+
+	s := generic.NewSet("apple", "orange", "apple")
+
+	// test membership
+	if s.Len() != 2 {
+		t.Errorf("Expected Len to return %v, but it returned %v", 2, s.Len())
+	}
+	if !s.Has("apple") || !s.Has("orange") {
+		t.Error("Expected Has to return true for members")
+	}
+	if s.Has("banana") {
+		t.Error("Expected Has to return false for non-members")
+	}
+
+	// test insertion order
+	s.Add("banana", "orange", "kiwi")
+	s.Remove("apple", "grape")
+	expected := []string{"orange", "banana", "kiwi"}
+	if result := s.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected ToSlice to return %v, but it returned %v", expected, result)
+	}
+	s.Add("apple")
+	expected = []string{"orange", "banana", "kiwi", "apple"}
+	if result := s.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected ToSlice to return %v, but it returned %v", expected, result)
+	}
+
+	// test removal of most members
+	s.Remove("orange", "banana", "kiwi")
+	expected = []string{"apple"}
+	if result := s.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected ToSlice to return %v, but it returned %v", expected, result)
+	}
+	if s.Len() != 1 {
+		t.Errorf("Expected Len to return %v, but it returned %v", 1, s.Len())
+	}
+
+	// test zero values
+	var zero generic.Set[int]
+	zero.Add(0, 1, 0)
+	zero.Remove(1)
+	zero.Add(2)
+	intExpected := []int{0, 2}
+	if result := zero.ToSlice(); !reflect.DeepEqual(result, intExpected) {
+		t.Errorf("Expected ToSlice to return %v, but it returned %v", intExpected, result)
+	}
+
+}
+
+func TestSetOperations(t *testing.T) {
+
+	s1 := generic.NewSet(1, 2, 3, 4)
+	s2 := generic.NewSet(6, 4, 2)
+
+	// test union
+	expected := []int{1, 2, 3, 4, 6}
+	if result := s1.Union(s2).ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected Union to return %v, but it returned %v", expected, result)
+	}
+
+	// test intersect
+	expected = []int{2, 4}
+	if result := s1.Intersect(s2).ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected Intersect to return %v, but it returned %v", expected, result)
+	}
+
+	// test difference
+	expected = []int{1, 3}
+	if result := s1.Difference(s2).ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected Difference to return %v, but it returned %v", expected, result)
+	}
+
+	// test subset
+	if !generic.NewSet(4, 2).IsSubset(s1) {
+		t.Error("Expected IsSubset to return true")
+	}
+	if s2.IsSubset(s1) {
+		t.Error("Expected IsSubset to return false")
+	}
+
+}
+
+func TestSetJSON(t *testing.T) {
+
+	// test marshal
+	data, err := json.Marshal(generic.NewSet("b", "a", "c"))
+	if err != nil {
+		t.Errorf("Expected Marshal to return no error, but got %v", err)
+	}
+	if string(data) != `["b","a","c"]` {
+		t.Errorf("Expected Marshal to return %v, but it returned %v", `["b","a","c"]`, string(data))
+	}
+
+	// test marshal of a set held by value
+	holder := struct {
+		S generic.Set[int] `json:"s"`
+	}{S: *generic.NewSet(2, 1)}
+	data, err = json.Marshal(holder)
+	if err != nil {
+		t.Errorf("Expected Marshal to return no error, but got %v", err)
+	}
+	if string(data) != `{"s":[2,1]}` {
+		t.Errorf("Expected Marshal to return %v, but it returned %v", `{"s":[2,1]}`, string(data))
+	}
+
+	// test round trip through a struct field
+	var decoded struct {
+		S generic.Set[int] `json:"s"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Expected Unmarshal to return no error, but got %v", err)
+	}
+	if result := decoded.S.ToSlice(); !reflect.DeepEqual(result, []int{2, 1}) {
+		t.Errorf("Expected Unmarshal to produce %v, but it produced %v", []int{2, 1}, result)
+	}
+
+	// test unmarshal
+	var s generic.Set[int]
+	if err := json.Unmarshal([]byte(`[3, 1, 3, 2]`), &s); err != nil {
+		t.Errorf("Expected Unmarshal to return no error, but got %v", err)
+	}
+	expected := []int{3, 1, 2}
+	if result := s.ToSlice(); !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected Unmarshal to produce %v, but it produced %v", expected, result)
+	}
+
+	// test unmarshal failure
+	if err := json.Unmarshal([]byte(`{"a": 1}`), &s); err == nil {
+		t.Error("Expected Unmarshal to return error")
+	}
+
+}
+
+func TestSetSelfUnequal(t *testing.T) {
+
+	type point struct{ x float64 }
+	nan := point{math.NaN()}
+
+	// test for members that never equal themselves
+	s := generic.NewSet(nan, point{1}, nan)
+	if s.Len() != 3 {
+		t.Errorf("Expected Len to return %v, but it returned %v", 3, s.Len())
+	}
+	if result := s.ToSlice(); len(result) != 3 || result[1] != (point{1}) {
+		t.Errorf("Expected ToSlice to return 3 members, but it returned %v", result)
+	}
+	if s.Has(nan) {
+		t.Error("Expected Has to return false")
+	}
+
+	// test for removal and compaction
+	s.Remove(nan, point{1})
+	if result := s.ToSlice(); len(result) != 2 || s.Len() != 2 || result[0] == result[0] || result[1] == result[1] {
+		t.Errorf("Expected Remove to keep 2 members, but the set holds %v", result)
+	}
+	s.Add(point{2}, point{3})
+	s.Remove(point{2}, point{3})
+	if result := s.ToSlice(); len(result) != 2 || s.Len() != 2 {
+		t.Errorf("Expected compaction to keep 2 members, but the set holds %v", result)
+	}
+
+	// test for set backed functions
+	if result := generic.Uniq([]point{nan, {1}, nan}); len(result) != 3 || result[1] != (point{1}) {
+		t.Errorf("Expected Uniq to return 3 elements, but it returned %v", result)
+	}
+	if result := generic.Union([]point{nan}, []point{{1}}); len(result) != 2 || result[1] != (point{1}) {
+		t.Errorf("Expected Union to return 2 elements, but it returned %v", result)
+	}
+	if result := generic.Intersection([]point{nan, {1}}, []point{nan, {1}}); len(result) != 1 || result[0] != (point{1}) {
+		t.Errorf("Expected Intersection to return %v, but it returned %v", []point{{1}}, result)
+	}
+
+}
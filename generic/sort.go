@@ -0,0 +1,46 @@
+package generic
+
+import (
+	"cmp"
+	"sort"
+)
+
+// SortBy creates a new slice with the elements of the provided slice sorted in ascending order of the keys returned by the provided function.
+// The sort is stable, so elements with equal keys keep their original order. The given slice is not modified.
+func SortBy[T any, K cmp.Ordered](slice []T, fn func(T) K) []T {
+	return OrderBy(slice, Asc(fn))
+}
+
+// OrderBy creates a new slice with the elements of the provided slice sorted by the provided comparison functions.
+// Each function must return a negative number, zero or a positive number when its first argument sorts before, equal to or after its second.
+// Elements are compared by the first function, ties are broken by the next function, and so on; Asc and Desc build such functions from keys.
+// The sort is stable, so elements that compare equal keep their original order. The given slice is not modified.
+func OrderBy[T any](slice []T, compares ...func(a, b T) int) []T {
+
+	dest := make([]T, len(slice))
+	copy(dest, slice)
+	sort.SliceStable(dest, func(i, j int) bool {
+		for _, compare := range compares {
+			if c := compare(dest[i], dest[j]); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+	return dest
+
+}
+
+// Asc returns a comparison function for OrderBy that sorts by the key returned by fn in ascending order.
+func Asc[T any, K cmp.Ordered](fn func(T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return cmp.Compare(fn(a), fn(b))
+	}
+}
+
+// Desc returns a comparison function for OrderBy that sorts by the key returned by fn in descending order.
+func Desc[T any, K cmp.Ordered](fn func(T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return cmp.Compare(fn(b), fn(a))
+	}
+}
@@ -0,0 +1,53 @@
+package generic_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zillow/godash/generic"
+)
+
+type listing struct {
+	city   string
+	price  float64
+	listed time.Time
+}
+
+func TestSortBy(t *testing.T) {
+
+	source := []int{3, 1, 2}
+	sorted := generic.SortBy(source, func(i int) int { return i })
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("Expected SortBy to return %v, but it returned %v", expected, sorted)
+	}
+	if !reflect.DeepEqual(source, []int{3, 1, 2}) {
+		t.Errorf("Expected SortBy to leave the source unchanged, but it is %v", source)
+	}
+
+}
+
+func TestOrderBy(t *testing.T) {
+
+	day := func(d int) time.Time {
+		return time.Date(2020, 1, d, 0, 0, 0, 0, time.UTC)
+	}
+	source := []listing{
+		{city: "Seattle", price: 500, listed: day(1)},
+		{city: "Denver", price: 300, listed: day(2)},
+		{city: "Seattle", price: 500, listed: day(3)},
+		{city: "Denver", price: 400, listed: day(4)},
+	}
+
+	sorted := generic.OrderBy(source,
+		generic.Asc(func(l listing) string { return l.city }),
+		generic.Desc(func(l listing) float64 { return l.price }),
+		func(a, b listing) int { return b.listed.Compare(a.listed) },
+	)
+	expected := []listing{source[3], source[1], source[2], source[0]}
+	if !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("Expected OrderBy to return %v, but it returned %v", expected, sorted)
+	}
+
+}
@@ -0,0 +1,51 @@
+package generic
+
+import (
+	"cmp"
+	"sort"
+)
+
+// SortedIndex uses a binary search to find the lowest index at which value should be inserted into the provided sorted slice to keep it sorted.
+func SortedIndex[T cmp.Ordered](slice []T, value T) int {
+	return sort.Search(len(slice), func(i int) bool { return cmp.Compare(slice[i], value) >= 0 })
+}
+
+// SortedLastIndex is like SortedIndex, except that it finds the highest index at which value should be inserted, i.e. the index after the last element equal to value.
+func SortedLastIndex[T cmp.Ordered](slice []T, value T) int {
+	return sort.Search(len(slice), func(i int) bool { return cmp.Compare(slice[i], value) > 0 })
+}
+
+// SortedIndexOf uses a binary search to find the index of the first element of the provided sorted slice that equals value.
+// If the value is not found in the slice, -1 is returned.
+func SortedIndexOf[T cmp.Ordered](slice []T, value T) int {
+
+	i := SortedIndex(slice, value)
+	if i == len(slice) || cmp.Compare(slice[i], value) != 0 {
+		return -1
+	}
+	return i
+
+}
+
+// SortedIndexBy is like SortedIndex, except that value and the elements of the slice are compared by the keys returned by the provided function.
+// The slice must be sorted in ascending order of these keys.
+func SortedIndexBy[T any, K cmp.Ordered](slice []T, value T, fn func(T) K) int {
+
+	key := fn(value)
+	return sort.Search(len(slice), func(i int) bool { return cmp.Compare(fn(slice[i]), key) >= 0 })
+
+}
+
+// SortedUniq removes duplicate values from the provided sorted slice and returns the new slice.
+// Because equal elements of a sorted slice are adjacent, each element is only compared with its predecessor.
+func SortedUniq[T comparable](slice []T) []T {
+
+	dest := make([]T, 0, len(slice))
+	for i, v := range slice {
+		if i == 0 || !sameValue(v, slice[i-1]) {
+			dest = append(dest, v)
+		}
+	}
+	return dest
+
+}
@@ -0,0 +1,62 @@
+package generic_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestSortedIndex(t *testing.T) {
+
+	source := []int{10, 20, 20, 20, 30}
+	for value, expected := range map[int]int{5: 0, 20: 1, 25: 4, 40: 5} {
+		if index := generic.SortedIndex(source, value); index != expected {
+			t.Errorf("Expected SortedIndex of %v to return %v, but it returned %v", value, expected, index)
+		}
+	}
+
+}
+
+func TestSortedLastIndex(t *testing.T) {
+
+	source := []int{10, 20, 20, 20, 30}
+	for value, expected := range map[int]int{5: 0, 20: 4, 30: 5} {
+		if index := generic.SortedLastIndex(source, value); index != expected {
+			t.Errorf("Expected SortedLastIndex of %v to return %v, but it returned %v", value, expected, index)
+		}
+	}
+
+}
+
+func TestSortedIndexOf(t *testing.T) {
+
+	source := []string{"apple", "banana", "banana", "orange"}
+	if index := generic.SortedIndexOf(source, "banana"); index != 1 {
+		t.Errorf("Expected SortedIndexOf to return %v, but it returned %v", 1, index)
+	}
+	if index := generic.SortedIndexOf(source, "cherry"); index != -1 {
+		t.Errorf("Expected SortedIndexOf to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestSortedIndexBy(t *testing.T) {
+
+	source := []listing{{price: 100}, {price: 200}, {price: 300}}
+	index := generic.SortedIndexBy(source, listing{price: 250}, func(l listing) float64 { return l.price })
+	if index != 2 {
+		t.Errorf("Expected SortedIndexBy to return %v, but it returned %v", 2, index)
+	}
+
+}
+
+func TestSortedUniq(t *testing.T) {
+
+	uniq := generic.SortedUniq([]int{1, 1, 2, 3, 3, 3, 4})
+	expected := []int{1, 2, 3, 4}
+	if !reflect.DeepEqual(uniq, expected) {
+		t.Errorf("Expected SortedUniq to return %v, but it returned %v", expected, uniq)
+	}
+
+}
@@ -0,0 +1,27 @@
+package generic
+
+// Union creates a slice of unique values that were present in either of the provided slices.
+// The items of the first given slice come first in their original order, followed by the new items of the second slice.
+func Union[T comparable](slice1 []T, slice2 []T) []T {
+	return NewSet(slice1...).Union(NewSet(slice2...)).ToSlice()
+}
+
+// UnionBy passes items from two provided slices through a provided function and creates a new slice with the first item that resulted in each unique key.
+// The items of the first given slice come first in their original order, followed by the new items of the second slice.
+func UnionBy[T any, K comparable](slice1 []T, slice2 []T, fn func(T) K) []T {
+
+	dest := make([]T, 0, len(slice1)+len(slice2))
+	m := make(map[K]bool, len(slice1)+len(slice2))
+
+	for _, slice := range [][]T{slice1, slice2} {
+		for _, v := range slice {
+			key := fn(v)
+			if !m[key] {
+				dest = append(dest, v)
+				m[key] = true
+			}
+		}
+	}
+	return dest
+
+}
@@ -0,0 +1,29 @@
+package generic_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestUnion(t *testing.T) {
+
+	result := generic.Union([]int{3, 17, 8, 3}, []int{8, 5, 17, 2, 5})
+	expected := []int{3, 17, 8, 5, 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected Union to return %v, but it returned %v", expected, result)
+	}
+
+}
+
+func TestUnionBy(t *testing.T) {
+
+	result := generic.UnionBy([]float64{2.16, 1.23, 2.5}, []float64{5.78, 1.49, 3.7}, math.Floor)
+	expected := []float64{2.16, 1.23, 5.78, 3.7}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected UnionBy to return %v, but it returned %v", expected, result)
+	}
+
+}
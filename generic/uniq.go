@@ -0,0 +1,48 @@
+package generic
+
+// Uniq removes duplicate values from a slice and returns the new slice.
+// The first occurrence of each value is kept and the original order is preserved. All NaN elements of a float slice are
+// treated as equal, so only the first is kept.
+func Uniq[T comparable](slice []T) []T {
+	return NewSet(slice...).ToSlice()
+}
+
+// UniqBy passes items from the provided slice through a provided function and removes items that resulted in duplicate keys.
+// The first occurrence of each key is kept and the original order is preserved.
+func UniqBy[T any, K comparable](slice []T, fn func(T) K) []T {
+
+	dest := make([]T, 0, len(slice))
+	m := make(map[K]bool, len(slice))
+
+	for _, v := range slice {
+		key := fn(v)
+		if !m[key] {
+			dest = append(dest, v)
+			m[key] = true
+		}
+	}
+	return dest
+
+}
+
+// UniqWith removes items from the provided slice that the provided function reports as equal to an earlier item.
+// The first occurrence of each value is kept and the original order is preserved.
+func UniqWith[T any](slice []T, fn func(a, b T) bool) []T {
+
+	dest := make([]T, 0, len(slice))
+
+	for _, v := range slice {
+		duplicate := false
+		for _, d := range dest {
+			if fn(d, v) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			dest = append(dest, v)
+		}
+	}
+	return dest
+
+}
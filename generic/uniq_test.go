@@ -0,0 +1,57 @@
+package generic_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestUniq(t *testing.T) {
+
+	// test for string success
+	stringSlice := generic.Uniq([]string{"apple", "orange", "apple", "banana", "orange"})
+	stringExpected := []string{"apple", "orange", "banana"}
+	if !reflect.DeepEqual(stringSlice, stringExpected) {
+		t.Errorf("Expected Uniq to return %v, but it returned %v", stringExpected, stringSlice)
+	}
+
+	// test for struct success
+	structSlice := generic.Uniq([]str{{name: "apple", foo: "bar"}, {name: "orange", foo: "bar"}, {name: "apple", foo: "-"}, {name: "orange", foo: "bar"}})
+	structExpected := []str{{name: "apple", foo: "bar"}, {name: "orange", foo: "bar"}, {name: "apple", foo: "-"}}
+	if !reflect.DeepEqual(structSlice, structExpected) {
+		t.Errorf("Expected Uniq to return %v, but it returned %v", structExpected, structSlice)
+	}
+
+	// test for empty slice
+	emptySlice := generic.Uniq([]int(nil))
+	if emptySlice == nil || len(emptySlice) > 0 {
+		t.Errorf("Expected Uniq to return empty slice, but got %v", emptySlice)
+	}
+
+}
+
+func TestUniqBy(t *testing.T) {
+
+	fn := func(s str) string {
+		return s.name
+	}
+
+	structSlice := generic.UniqBy([]str{{name: "apple", foo: "bar"}, {name: "orange", foo: "bar"}, {name: "apple", foo: "-"}}, fn)
+	structExpected := []str{{name: "apple", foo: "bar"}, {name: "orange", foo: "bar"}}
+	if !reflect.DeepEqual(structSlice, structExpected) {
+		t.Errorf("Expected UniqBy to return %v, but it returned %v", structExpected, structSlice)
+	}
+
+}
+
+func TestUniqWith(t *testing.T) {
+
+	stringSlice := generic.UniqWith([]string{"Apple", "orange", "apple", "ORANGE", "banana"}, strings.EqualFold)
+	stringExpected := []string{"Apple", "orange", "banana"}
+	if !reflect.DeepEqual(stringSlice, stringExpected) {
+		t.Errorf("Expected UniqWith to return %v, but it returned %v", stringExpected, stringSlice)
+	}
+
+}
@@ -0,0 +1,46 @@
+package generic
+
+// Without removes values from a slice and returns the new slice.
+// A NaN value removes every NaN element of a float slice.
+func Without[T comparable](slice []T, values ...T) []T {
+
+	remove := NewSet(values...)
+
+	dest := make([]T, 0, len(slice))
+	for _, v := range slice {
+		if !remove.Has(v) {
+			dest = append(dest, v)
+		}
+	}
+	return dest
+
+}
+
+// WithoutWith removes values from a slice that the provided function reports equal to any of the values, and returns the new slice.
+// The function is called with an element and a value to remove.
+func WithoutWith[T any](slice []T, eq func(T, T) bool, values ...T) []T {
+
+	return WithoutBy(slice, func(v T) bool {
+		for _, value := range values {
+			if eq(v, value) {
+				return true
+			}
+		}
+		return false
+	})
+
+}
+
+// WithoutBy removes values from a slice based on output from a provided function and returns the new slice.
+// Values for which the function returns true will be removed from the slice.
+func WithoutBy[T any](slice []T, fn func(T) bool) []T {
+
+	dest := make([]T, 0, len(slice))
+	for _, v := range slice {
+		if !fn(v) {
+			dest = append(dest, v)
+		}
+	}
+	return dest
+
+}
@@ -0,0 +1,62 @@
+package generic_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestWithout(t *testing.T) {
+
+	// test for string success
+	stringDest := generic.Without([]string{"one", "two", "three", "four", "five", "six"}, "two", "four")
+	stringExpected := []string{"one", "three", "five", "six"}
+	if !reflect.DeepEqual(stringDest, stringExpected) {
+		t.Errorf("Expected Without to return %v, but it returned %v", stringExpected, stringDest)
+	}
+
+	// test for float32 success
+	floatDest := generic.Without([]float32{1.4, 2.0, 3.3, 4.7, 5.1, 6.0}, 2.0, 4.7)
+	floatExpected := []float32{1.4, 3.3, 5.1, 6.0}
+	if !reflect.DeepEqual(floatDest, floatExpected) {
+		t.Errorf("Expected Without to return %v, but it returned %v", floatExpected, floatDest)
+	}
+
+	// test for struct success
+	structDest := generic.Without([]str{{name: "first"}, {name: "second"}, {name: "third"}}, str{name: "second"})
+	structExpected := []str{{name: "first"}, {name: "third"}}
+	if !reflect.DeepEqual(structDest, structExpected) {
+		t.Errorf("Expected Without to return %v, but it returned %v", structExpected, structDest)
+	}
+
+}
+
+func TestWithoutWith(t *testing.T) {
+
+	eq := func(a, b string) bool {
+		return strings.EqualFold(a, b)
+	}
+
+	result := generic.WithoutWith([]string{"One", "two", "THREE", "one"}, eq, "ONE", "three")
+	expected := []string{"two"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected WithoutWith to return %v, but it returned %v", expected, result)
+	}
+
+}
+
+func TestWithoutBy(t *testing.T) {
+
+	fn := func(i int) bool {
+		return i%2 == 0
+	}
+	expected := []int{1, 3, 5}
+
+	dest := generic.WithoutBy([]int{1, 2, 3, 4, 5, 6}, fn)
+	if !reflect.DeepEqual(dest, expected) {
+		t.Errorf("Expected WithoutBy to return %v, but it returned %v", expected, dest)
+	}
+
+}
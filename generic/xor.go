@@ -0,0 +1,39 @@
+package generic
+
+// Xor creates a slice of unique values that were present in exactly one of the provided slices, i.e. their symmetric difference.
+// The items taken from the first given slice come first in their original order, followed by the items taken from the second slice.
+func Xor[T comparable](slice1 []T, slice2 []T) []T {
+
+	set1, set2 := NewSet(slice1...), NewSet(slice2...)
+	return set1.Difference(set2).Union(set2.Difference(set1)).ToSlice()
+
+}
+
+// XorBy passes items from two provided slices through a provided function and creates a new slice with the items whose keys were present in exactly one of the slices.
+// The first item that resulted in each such key is kept. Items taken from the first given slice come first, followed by items taken from the second slice.
+func XorBy[T any, K comparable](slice1 []T, slice2 []T, fn func(T) K) []T {
+
+	slices := [][]T{slice1, slice2}
+
+	// sources records which of the two slices (1, 2, or 3 for both) each key was seen in
+	sources := make(map[K]int, len(slice1)+len(slice2))
+	for s, slice := range slices {
+		for _, v := range slice {
+			sources[fn(v)] |= 1 << s
+		}
+	}
+
+	dest := make([]T, 0, len(slice1)+len(slice2))
+	appended := make(map[K]bool, len(slice1)+len(slice2))
+	for s, slice := range slices {
+		for _, v := range slice {
+			key := fn(v)
+			if sources[key] == 1<<s && !appended[key] {
+				dest = append(dest, v)
+				appended[key] = true
+			}
+		}
+	}
+	return dest
+
+}
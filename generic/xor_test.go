@@ -0,0 +1,29 @@
+package generic_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestXor(t *testing.T) {
+
+	result := generic.Xor([]int{3, 17, 8, 3, 11}, []int{8, 5, 17, 5, 2})
+	expected := []int{3, 11, 5, 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected Xor to return %v, but it returned %v", expected, result)
+	}
+
+}
+
+func TestXorBy(t *testing.T) {
+
+	result := generic.XorBy([]float64{2.16, 1.23, 5.4}, []float64{5.78, 2.49, 3.7, 3.1}, math.Floor)
+	expected := []float64{1.23, 3.7}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected XorBy to return %v, but it returned %v", expected, result)
+	}
+
+}
@@ -0,0 +1,66 @@
+package generic
+
+// Pair holds an element from each of two slices, as produced by Zip.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs up the elements of the two provided slices by index.
+// The result is as long as the longer slice; the shorter slice is padded with zero values.
+// Use ZipShortest to stop at the end of the shorter slice instead.
+func Zip[A any, B any](a []A, b []B) []Pair[A, B] {
+	return ZipWith(a, b, func(x A, y B) Pair[A, B] { return Pair[A, B]{x, y} })
+}
+
+// ZipShortest is like Zip, except that the result is only as long as the shorter slice.
+func ZipShortest[A any, B any](a []A, b []B) []Pair[A, B] {
+	return ZipWithShortest(a, b, func(x A, y B) Pair[A, B] { return Pair[A, B]{x, y} })
+}
+
+// ZipWith is like Zip, except that the elements at each index are combined by the provided function instead of being paired.
+func ZipWith[A any, B any, R any](a []A, b []B, fn func(A, B) R) []R {
+	return zipWith(a, b, fn, len(a) > len(b))
+}
+
+// ZipWithShortest is like ZipWith, except that the result is only as long as the shorter slice.
+func ZipWithShortest[A any, B any, R any](a []A, b []B, fn func(A, B) R) []R {
+	return zipWith(a, b, fn, len(a) < len(b))
+}
+
+// Unzip reverses Zip, splitting a slice of pairs into a slice of the first elements and a slice of the second elements.
+func Unzip[A any, B any](pairs []Pair[A, B]) ([]A, []B) {
+
+	a := make([]A, len(pairs))
+	b := make([]B, len(pairs))
+	for i, p := range pairs {
+		a[i] = p.First
+		b[i] = p.Second
+	}
+	return a, b
+
+}
+
+// zipWith combines the elements of a and b with fn, for as many elements as a holds if useA is true, or as b holds otherwise.
+func zipWith[A any, B any, R any](a []A, b []B, fn func(A, B) R, useA bool) []R {
+
+	n := len(b)
+	if useA {
+		n = len(a)
+	}
+
+	dest := make([]R, n)
+	for i := range dest {
+		var x A
+		var y B
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		dest[i] = fn(x, y)
+	}
+	return dest
+
+}
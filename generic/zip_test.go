@@ -0,0 +1,61 @@
+package generic_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/zillow/godash/generic"
+)
+
+func TestZip(t *testing.T) {
+
+	ids := []int{1, 2, 3}
+	names := []string{"a", "b"}
+
+	// test for padded success
+	zipped := generic.Zip(ids, names)
+	expected := []generic.Pair[int, string]{{1, "a"}, {2, "b"}, {3, ""}}
+	if !reflect.DeepEqual(zipped, expected) {
+		t.Errorf("Expected Zip to return %v, but it returned %v", expected, zipped)
+	}
+
+	// test for truncated success
+	zipped = generic.ZipShortest(ids, names)
+	expected = []generic.Pair[int, string]{{1, "a"}, {2, "b"}}
+	if !reflect.DeepEqual(zipped, expected) {
+		t.Errorf("Expected ZipShortest to return %v, but it returned %v", expected, zipped)
+	}
+
+}
+
+func TestUnzip(t *testing.T) {
+
+	ids, names := generic.Unzip([]generic.Pair[int, string]{{1, "a"}, {2, "b"}})
+	if !reflect.DeepEqual(ids, []int{1, 2}) || !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Errorf("Expected Unzip to return [1 2] and [a b], but it returned %v and %v", ids, names)
+	}
+
+}
+
+func TestZipWith(t *testing.T) {
+
+	fn := func(s string, i int) string {
+		return s + ":" + strconv.Itoa(i)
+	}
+
+	// test for padded success
+	zipped := generic.ZipWith([]string{"a", "b", "c"}, []int{1, 2}, fn)
+	expected := []string{"a:1", "b:2", "c:0"}
+	if !reflect.DeepEqual(zipped, expected) {
+		t.Errorf("Expected ZipWith to return %v, but it returned %v", expected, zipped)
+	}
+
+	// test for truncated success
+	zipped = generic.ZipWithShortest([]string{"a", "b", "c"}, []int{1, 2}, fn)
+	expected = []string{"a:1", "b:2"}
+	if !reflect.DeepEqual(zipped, expected) {
+		t.Errorf("Expected ZipWithShortest to return %v, but it returned %v", expected, zipped)
+	}
+
+}
@@ -0,0 +1,3 @@
+module github.com/zillow/godash
+
+go 1.21
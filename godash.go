@@ -1,5 +1,11 @@
 // Package godash provides utility functions for searching and manipulating slices in golang.
 // Inspired by the Lodash library in Javascript.
+// Type-safe counterparts built on Go generics are provided by the generic subpackage.
+//
+// Float NaN values are never equal to themselves in Go. Where godash compares elements by value, as in FindIndex,
+// Uniq, SortedUniq, Intersection, Union, Difference, Xor, Without and Pull, all NaNs of the same type are instead treated as
+// equal, like lodash does, so Uniq keeps a single NaN and Without can remove NaNs. The ...Approx variants compare
+// float32 and float64 values within an absolute tolerance.
 package godash
 
 // shared types
@@ -7,3 +13,11 @@ package godash
 type validator func(interface{}) bool
 
 type mutator func(interface{}) interface{}
+
+type comparator func(interface{}, interface{}) bool
+
+type reducer func(interface{}, interface{}) interface{}
+
+type folder func(interface{}, interface{}) (interface{}, bool)
+
+type zipper func(...interface{}) interface{}
@@ -0,0 +1,155 @@
+package godash
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// KeyConflict determines how KeyBy handles elements of a slice that result in the same key.
+type KeyConflict int
+
+const (
+	// KeepLast stores the last element that resulted in a key.
+	KeepLast KeyConflict = iota
+	// KeepFirst stores the first element that resulted in a key.
+	KeepFirst
+	// RejectConflict makes KeyBy return an error wrapping ErrDuplicateKey.
+	RejectConflict
+)
+
+// ErrDuplicateKey is reported by KeyBy with RejectConflict when two elements result in the same key.
+var ErrDuplicateKey = errors.New("godash: duplicate key")
+
+var interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+
+// GroupBy passes every element of the provided slice through a provided mutator function and groups the elements by the resulting keys.
+// The supplied mutator function must accept an interface{} parameter and return a hashable interface{} to be used as the key.
+// The result is a map from each key to a slice of the elements that resulted in it, in their original order.
+// The key type of the map is the type of the keys if they all share the same type, otherwise it is interface{}.
+// The new map is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func GroupBy(slice interface{}, fn mutator) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("GroupBy", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("GroupBy", 2)
+	}
+	keys, err := mapKeys("GroupBy", sliceVal, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	groupType := reflect.SliceOf(sliceVal.Type().Elem())
+	dest := reflect.MakeMap(reflect.MapOf(keyType(keys), groupType))
+	for i, key := range keys {
+		keyVal := reflect.ValueOf(key)
+		group := dest.MapIndex(keyVal)
+		if !group.IsValid() {
+			group = reflect.MakeSlice(groupType, 0, 1)
+		}
+		dest.SetMapIndex(keyVal, reflect.Append(group, sliceVal.Index(i)))
+	}
+	return dest.Interface(), nil
+
+}
+
+// KeyBy passes every element of the provided slice through a provided mutator function and creates a map from the resulting keys to the elements.
+// The supplied mutator function must accept an interface{} parameter and return a hashable interface{} to be used as the key.
+// When several elements result in the same key, onConflict determines whether the last or first of them is kept, or whether an error is returned.
+// The key type of the map is the type of the keys if they all share the same type, otherwise it is interface{}.
+// The new map is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func KeyBy(slice interface{}, fn mutator, onConflict KeyConflict) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("KeyBy", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("KeyBy", 2)
+	}
+	keys, err := mapKeys("KeyBy", sliceVal, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	dest := reflect.MakeMap(reflect.MapOf(keyType(keys), sliceVal.Type().Elem()))
+	for i, key := range keys {
+		keyVal := reflect.ValueOf(key)
+		if dest.MapIndex(keyVal).IsValid() {
+			if onConflict == KeepFirst {
+				continue
+			}
+			if onConflict == RejectConflict {
+				return nil, fmt.Errorf("godash: KeyBy func found key %v for more than one element: %w", key, ErrDuplicateKey)
+			}
+		}
+		dest.SetMapIndex(keyVal, sliceVal.Index(i))
+	}
+	return dest.Interface(), nil
+
+}
+
+// CountBy passes every element of the provided slice through a provided mutator function and counts how many elements resulted in each key.
+// The supplied mutator function must accept an interface{} parameter and return a hashable interface{} to be used as the key.
+// The key type of the map is the type of the keys if they all share the same type, otherwise it is interface{}.
+// The new map[K]int is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func CountBy(slice interface{}, fn mutator) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("CountBy", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("CountBy", 2)
+	}
+	keys, err := mapKeys("CountBy", sliceVal, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	dest := reflect.MakeMap(reflect.MapOf(keyType(keys), reflect.TypeOf(0)))
+	for _, key := range keys {
+		keyVal := reflect.ValueOf(key)
+		count := 0
+		if countVal := dest.MapIndex(keyVal); countVal.IsValid() {
+			count = int(countVal.Int())
+		}
+		dest.SetMapIndex(keyVal, reflect.ValueOf(count+1))
+	}
+	return dest.Interface(), nil
+
+}
+
+// mapKeys passes every element of sliceVal through fn on behalf of function name, and returns the resulting keys
+// or an error if one of them cannot be used as a map key.
+func mapKeys(name string, sliceVal reflect.Value, fn mutator) ([]interface{}, error) {
+
+	keys := make([]interface{}, sliceVal.Len())
+	for i := 0; i < sliceVal.Len(); i++ {
+		keys[i] = fn(sliceVal.Index(i).Interface())
+		if keys[i] == nil || !isHashable(keys[i]) {
+			return nil, &ArgumentError{Func: name, Param: 2, Expected: "a function returning hashable keys", Got: "a function returning " + typeName(keys[i]), Err: ErrTypeMismatch}
+		}
+	}
+	return keys, nil
+
+}
+
+// keyType returns the type shared by all keys, or the interface{} type if they are of different types or there are none.
+func keyType(keys []interface{}) reflect.Type {
+
+	if len(keys) == 0 {
+		return interfaceType
+	}
+	t := reflect.TypeOf(keys[0])
+	for _, key := range keys[1:] {
+		if reflect.TypeOf(key) != t {
+			return interfaceType
+		}
+	}
+	return t
+
+}
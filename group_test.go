@@ -0,0 +1,141 @@
+package godash_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestGroupBy(t *testing.T) {
+
+	nameFn := func(x interface{}) interface{} {
+		return x.(str).name
+	}
+
+	// test for struct success
+	source := []str{{name: "apple", foo: "1"}, {name: "orange", foo: "2"}, {name: "apple", foo: "3"}}
+	groups, err := godash.GroupBy(source, nameFn)
+	expected := map[string][]str{
+		"apple":  {{name: "apple", foo: "1"}, {name: "apple", foo: "3"}},
+		"orange": {{name: "orange", foo: "2"}},
+	}
+	if err != nil {
+		t.Errorf("Expected GroupBy to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(groups, expected) {
+		t.Errorf("Expected GroupBy to return %v, but it returned %v", expected, groups)
+	}
+
+	// test for mixed key types
+	mixedGroups, err := godash.GroupBy([]int{1, 2, 3}, func(x interface{}) interface{} {
+		if x.(int)%2 == 0 {
+			return "even"
+		}
+		return 1
+	})
+	mixedExpected := map[interface{}][]int{1: {1, 3}, "even": {2}}
+	if err != nil {
+		t.Errorf("Expected GroupBy to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(mixedGroups, mixedExpected) {
+		t.Errorf("Expected GroupBy to return %v, but it returned %v", mixedExpected, mixedGroups)
+	}
+
+	// test for failure
+	fail, err := godash.GroupBy(source, func(x interface{}) interface{} { return []string{x.(str).name} })
+	if !errors.Is(err, godash.ErrTypeMismatch) {
+		t.Errorf("Expected GroupBy to return ErrTypeMismatch, but got %v", err)
+	}
+	if fail != nil {
+		t.Errorf("Expected GroupBy to return nil result, but got %v", fail)
+	}
+	fail, err = godash.GroupBy(str{name: "apple"}, nameFn)
+	if err == nil {
+		t.Error("Expected GroupBy to return error")
+	}
+	if fail != nil {
+		t.Errorf("Expected GroupBy to return nil result, but got %v", fail)
+	}
+
+}
+
+func TestKeyBy(t *testing.T) {
+
+	nameFn := func(x interface{}) interface{} {
+		return x.(str).name
+	}
+	source := []str{{name: "apple", foo: "1"}, {name: "orange", foo: "2"}, {name: "apple", foo: "3"}}
+
+	// test for last wins
+	keyed, err := godash.KeyBy(source, nameFn, godash.KeepLast)
+	expected := map[string]str{"apple": {name: "apple", foo: "3"}, "orange": {name: "orange", foo: "2"}}
+	if err != nil {
+		t.Errorf("Expected KeyBy to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(keyed, expected) {
+		t.Errorf("Expected KeyBy to return %v, but it returned %v", expected, keyed)
+	}
+
+	// test for first wins
+	keyed, err = godash.KeyBy(source, nameFn, godash.KeepFirst)
+	expected = map[string]str{"apple": {name: "apple", foo: "1"}, "orange": {name: "orange", foo: "2"}}
+	if err != nil {
+		t.Errorf("Expected KeyBy to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(keyed, expected) {
+		t.Errorf("Expected KeyBy to return %v, but it returned %v", expected, keyed)
+	}
+
+	// test for conflict error
+	keyed, err = godash.KeyBy(source, nameFn, godash.RejectConflict)
+	if !errors.Is(err, godash.ErrDuplicateKey) {
+		t.Errorf("Expected KeyBy to return ErrDuplicateKey, but got %v", err)
+	}
+	if keyed != nil {
+		t.Errorf("Expected KeyBy to return nil result, but got %v", keyed)
+	}
+	keyed, err = godash.KeyBy(source[:2], nameFn, godash.RejectConflict)
+	if err != nil {
+		t.Errorf("Expected KeyBy to return no error, but got %v", err)
+	}
+	if reflect.ValueOf(keyed).Len() != 2 {
+		t.Errorf("Expected KeyBy to return 2 keys, but it returned %v", keyed)
+	}
+
+}
+
+func TestCountBy(t *testing.T) {
+
+	// test for success
+	counts, err := godash.CountBy([]float64{1.2, 2.5, 1.7, 3.1}, func(x interface{}) interface{} {
+		return int(x.(float64))
+	})
+	expected := map[int]int{1: 2, 2: 1, 3: 1}
+	if err != nil {
+		t.Errorf("Expected CountBy to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("Expected CountBy to return %v, but it returned %v", expected, counts)
+	}
+
+	// test for empty slice
+	counts, err = godash.CountBy([]float64{}, func(x interface{}) interface{} { return x })
+	if err != nil {
+		t.Errorf("Expected CountBy to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(counts, map[interface{}]int{}) {
+		t.Errorf("Expected CountBy to return empty map, but it returned %v", counts)
+	}
+
+	// test for failure
+	counts, err = godash.CountBy([]float64{1}, nil)
+	if err == nil {
+		t.Error("Expected CountBy to return error")
+	}
+	if counts != nil {
+		t.Errorf("Expected CountBy to return nil result, but got %v", counts)
+	}
+
+}
@@ -0,0 +1,232 @@
+package godash
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"reflect"
+)
+
+// maxHashDepth bounds how far hashValue descends into nested values, which also protects against cyclic data.
+const maxHashDepth = 16
+
+// Hasher is implemented by types whose identity differs from field-wise equality, such as a normalized address.
+// Uniq, Intersection, Union, Difference, Xor and the other functions that look values up in a set compare the
+// result of HashKey instead of the value itself, so values of the same type with equal keys are treated as the same value.
+// A value never matches a value of another type, even if their keys are equal.
+// HashKey should return a plain value such as a string or a struct of comparable fields.
+// The functions in the generic package compare values with == and do not use Hasher; pass HashKey to generic.UniqBy
+// or the other ...By functions there instead.
+type Hasher interface {
+	HashKey() interface{}
+}
+
+// valueMap associates an int, such as a count or a set of flags, with arbitrary keys.
+// Hashable values are used as map keys directly. Values that cannot be map keys, such as slices, maps
+// or structs containing them, are bucketed by a structural hash and compared with reflect.DeepEqual,
+// so lookups stay close to constant time without panicking on unhashable types.
+// Keys that implement Hasher are stored under their HashKey, and NaN keys of the same float type all match each other.
+type valueMap struct {
+	keys    map[interface{}]int
+	buckets map[uint64][]valueEntry
+}
+
+type valueEntry struct {
+	key interface{}
+	val int
+}
+
+func newValueMap(size int) *valueMap {
+	return &valueMap{keys: make(map[interface{}]int, size)}
+}
+
+// get returns the value stored for key and whether the key is present.
+func (m *valueMap) get(key interface{}) (int, bool) {
+
+	key = hashKey(key)
+	if isHashable(key) {
+		val, ok := m.keys[key]
+		return val, ok
+	}
+	for _, e := range m.buckets[hashValue(reflect.ValueOf(key), 0)] {
+		if reflect.DeepEqual(e.key, key) {
+			return e.val, true
+		}
+	}
+	return 0, false
+
+}
+
+// set stores val for key, replacing any existing value.
+func (m *valueMap) set(key interface{}, val int) {
+
+	key = hashKey(key)
+	if isHashable(key) {
+		m.keys[key] = val
+		return
+	}
+	if m.buckets == nil {
+		m.buckets = make(map[uint64][]valueEntry)
+	}
+	h := hashValue(reflect.ValueOf(key), 0)
+	bucket := m.buckets[h]
+	for i := range bucket {
+		if reflect.DeepEqual(bucket[i].key, key) {
+			bucket[i].val = val
+			return
+		}
+	}
+	m.buckets[h] = append(bucket, valueEntry{key: key, val: val})
+
+}
+
+// has reports whether key is present.
+func (m *valueMap) has(key interface{}) bool {
+	_, ok := m.get(key)
+	return ok
+}
+
+// add stores key with a value of zero if it is not already present.
+func (m *valueMap) add(key interface{}) {
+	if !m.has(key) {
+		m.set(key, 0)
+	}
+}
+
+// hashKey returns the key that v is stored under in a valueMap: its HashKey if it implements Hasher, or v itself.
+// HashKey results are tagged with the type of v, so they never match a raw value or the key of another Hasher type.
+func hashKey(v interface{}) interface{} {
+	if h, ok := v.(Hasher); ok {
+		return hasherKey{t: reflect.TypeOf(v), k: normalizeNaN(h.HashKey())}
+	}
+	return normalizeNaN(v)
+}
+
+// hasherKey stands in for a value of type t that implements Hasher and returned k from HashKey.
+type hasherKey struct {
+	t reflect.Type
+	k interface{}
+}
+
+// nanKey stands in for the NaN values of a float type, which never match themselves when used as map keys.
+type nanKey struct {
+	t reflect.Type
+}
+
+// normalizeNaN returns a nanKey in place of a float32 or float64 NaN, so that all NaNs of the same type are treated as
+// equal, and v itself otherwise.
+func normalizeNaN(v interface{}) interface{} {
+
+	val := reflect.ValueOf(v)
+	if isFloat(val.Kind()) && math.IsNaN(val.Float()) {
+		return nanKey{t: val.Type()}
+	}
+	return v
+
+}
+
+// isFloat reports whether k is a floating-point kind.
+func isFloat(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+// isHashable reports whether v can be used as a map key without panicking.
+func isHashable(v interface{}) bool {
+	return v == nil || reflect.ValueOf(v).Comparable()
+}
+
+// isFlatComparable reports whether values of type t can be compared with == and get the same result as reflect.DeepEqual.
+// This holds for booleans, numbers and strings, and arrays and structs made up only of those; pointers and interfaces
+// are excluded because DeepEqual compares what they refer to rather than their identity.
+func isFlatComparable(t reflect.Type) bool {
+
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	case reflect.Array:
+		return isFlatComparable(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !isFlatComparable(t.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+
+}
+
+// hashValue computes a structural hash of v that is consistent with reflect.DeepEqual:
+// values that are deeply equal always produce the same hash.
+func hashValue(v reflect.Value, depth int) uint64 {
+
+	h := fnv.New64a()
+	var buf [8]byte
+	write := func(x uint64) {
+		binary.LittleEndian.PutUint64(buf[:], x)
+		h.Write(buf[:])
+	}
+
+	if !v.IsValid() {
+		return h.Sum64()
+	}
+	write(uint64(v.Kind()))
+	if depth > maxHashDepth {
+		return h.Sum64()
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			write(1)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		write(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		write(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		write(hashFloat(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		write(hashFloat(real(c)))
+		write(hashFloat(imag(c)))
+	case reflect.String:
+		h.Write([]byte(v.String()))
+	case reflect.Slice, reflect.Array:
+		write(uint64(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			write(hashValue(v.Index(i), depth+1))
+		}
+	case reflect.Map:
+		// map iteration order is random, so entries are combined with an order-independent sum
+		var sum uint64
+		iter := v.MapRange()
+		for iter.Next() {
+			sum += hashValue(iter.Key(), depth+1)*31 + hashValue(iter.Value(), depth+1)
+		}
+		write(uint64(v.Len()))
+		write(sum)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			write(hashValue(v.Field(i), depth+1))
+		}
+	case reflect.Interface:
+		write(hashValue(v.Elem(), depth+1))
+	}
+	// pointers, channels, funcs and unsafe pointers contribute only their kind; DeepEqual resolves collisions
+
+	return h.Sum64()
+
+}
+
+// hashFloat returns the bits of f, treating positive and negative zero as the same value.
+func hashFloat(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+	return math.Float64bits(f)
+}
@@ -0,0 +1,106 @@
+package godash_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+// address is identified by its normalized street and zip code, ignoring case, spacing and the unit label
+type address struct {
+	street string
+	zip    string
+	label  string
+}
+
+func (a address) HashKey() interface{} {
+	return strings.Join(strings.Fields(strings.ToLower(a.street)), " ") + "|" + a.zip
+}
+
+// userID and listingID are distinct identifiers that share a numeric key space
+type userID int
+
+func (id userID) HashKey() interface{} {
+	return int(id)
+}
+
+type listingID int
+
+func (id listingID) HashKey() interface{} {
+	return int(id)
+}
+
+func TestHasher(t *testing.T) {
+
+	first := address{street: "1 Main St", zip: "98101", label: "home"}
+	second := address{street: "2 Pine St", zip: "98101"}
+	third := address{street: "3 Oak St", zip: "98102"}
+	source := []address{first, {street: "1  MAIN st", zip: "98101", label: "work"}, second}
+
+	// test for Uniq success
+	result, err := godash.Uniq(source)
+	if err != nil {
+		t.Errorf("Expected Uniq to return no error, but got %v", err)
+	}
+	expected := []address{first, second}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected Uniq to return %v, but it returned %v", expected, result)
+	}
+
+	// test for Intersection success
+	result, err = godash.Intersection(source, []address{{street: "2 PINE ST", zip: "98101"}, third})
+	if err != nil {
+		t.Errorf("Expected Intersection to return no error, but got %v", err)
+	}
+	expected = []address{second}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected Intersection to return %v, but it returned %v", expected, result)
+	}
+
+	// test for Difference success
+	result, err = godash.Difference(source, []address{{street: "1 main st", zip: "98101"}})
+	if err != nil {
+		t.Errorf("Expected Difference to return no error, but got %v", err)
+	}
+	expected = []address{second}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected Difference to return %v, but it returned %v", expected, result)
+	}
+
+	// test for different keys
+	result, err = godash.Uniq([]address{first, {street: "1 Main St", zip: "98102"}})
+	if err != nil {
+		t.Errorf("Expected Uniq to return no error, but got %v", err)
+	}
+	if len(result.([]address)) != 2 {
+		t.Errorf("Expected Uniq to return %v elements, but it returned %v", 2, result)
+	}
+
+	// test for mixed types with equal keys
+	result, err = godash.Uniq([]interface{}{userID(5), listingID(5), 5, userID(5)})
+	if err != nil {
+		t.Errorf("Expected Uniq to return no error, but got %v", err)
+	}
+	mixed := []interface{}{userID(5), listingID(5), 5}
+	if !reflect.DeepEqual(result, mixed) {
+		t.Errorf("Expected Uniq to return %v, but it returned %v", mixed, result)
+	}
+	key := first.HashKey()
+	result, err = godash.Uniq([]interface{}{first, key})
+	if err != nil {
+		t.Errorf("Expected Uniq to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(result, []interface{}{first, key}) {
+		t.Errorf("Expected Uniq to return %v, but it returned %v", []interface{}{first, key}, result)
+	}
+	result, err = godash.Intersection([]interface{}{key}, []interface{}{first})
+	if err != nil {
+		t.Errorf("Expected Intersection to return no error, but got %v", err)
+	}
+	if len(result.([]interface{})) != 0 {
+		t.Errorf("Expected Intersection to return empty slice, but it returned %v", result)
+	}
+
+}
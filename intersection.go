@@ -1,43 +1,42 @@
 package godash
 
 import (
-	"errors"
 	"reflect"
 )
 
 // Intersection creates a slice of unique values that were present in both of the provided slices.
 // The order of the items in the resulting slice is determined by the first given slice.
+// Elements that implement Hasher are compared by their HashKey.
+// Elements that cannot be used as map keys, such as slices or maps, are compared with reflect.DeepEqual.
 // The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
 func Intersection(slice1 interface{}, slice2 interface{}) (interface{}, error) {
 
 	sliceVal1 := reflect.ValueOf(slice1)
 	sliceVal2 := reflect.ValueOf(slice2)
 
-	if sliceVal1.Type().Kind() != reflect.Slice {
-		return nil, errors.New("godash: invalid parameter type. Intersection func expects parameter 1 to be a slice")
+	if sliceVal1.Kind() != reflect.Slice {
+		return nil, notSliceError("Intersection", 1, slice1)
 	}
-	if sliceVal2.Type().Kind() != reflect.Slice {
-		return nil, errors.New("godash: invalid parameter type. Intersection func expects parameter 2 to be a slice")
+	if sliceVal2.Kind() != reflect.Slice {
+		return nil, notSliceError("Intersection", 2, slice2)
 	}
 	if sliceVal1.Type().Elem() != sliceVal2.Type().Elem() {
-		return nil, errors.New("godash: invalid parameter type. Intersection func expects two slice parameters of the same type")
+		return nil, typeMismatchError("Intersection", 2, reflect.SliceOf(sliceVal1.Type().Elem()), slice2)
 	}
 
 	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice1).Elem()), 0, sliceVal1.Len())
-	m := make(map[interface{}]bool)
+	m := newValueMap(sliceVal2.Len())
 
 	for i := 0; i < sliceVal2.Len(); i++ {
 		val := sliceVal2.Index(i).Interface()
-		m[val] = false
+		m.add(val)
 	}
 	for i := 0; i < sliceVal1.Len(); i++ {
 		val := sliceVal1.Index(i).Interface()
-		appended, exists := m[val]
-		if exists {
-			if !appended {
-				dest = reflect.Append(dest, sliceVal1.Index(i))
-			}
-			m[val] = true
+		// values present in the second slice are stored as 0, and set to 1 once appended
+		if appended, exists := m.get(val); exists && appended == 0 {
+			dest = reflect.Append(dest, sliceVal1.Index(i))
+			m.set(val, 1)
 		}
 	}
 	return dest.Interface(), nil
@@ -47,40 +46,109 @@ func Intersection(slice1 interface{}, slice2 interface{}) (interface{}, error) {
 // IntersectionBy passes items from two provided slices through a provided mutator function and creates a new slice with items that resulted in common mutated values.
 // The supplied mutator function must accept an interface{} parameter and return interface{} with the value to be compared.
 // The order and values of the items in the resulting slice are determined by the first given slice.
+// Mutated values that cannot be used as map keys, such as slices or maps, are compared with reflect.DeepEqual.
 // The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
 func IntersectionBy(slice1 interface{}, slice2 interface{}, fn mutator) (interface{}, error) {
 
 	sliceVal1 := reflect.ValueOf(slice1)
 	sliceVal2 := reflect.ValueOf(slice2)
 
-	if sliceVal1.Type().Kind() != reflect.Slice {
-		return nil, errors.New("godash: invalid parameter type. IntersectionBy func expects parameter 1 to be a slice")
+	if sliceVal1.Kind() != reflect.Slice {
+		return nil, notSliceError("IntersectionBy", 1, slice1)
 	}
-	if sliceVal2.Type().Kind() != reflect.Slice {
-		return nil, errors.New("godash: invalid parameter type. IntersectionBy func expects parameter 2 to be a slice")
+	if sliceVal2.Kind() != reflect.Slice {
+		return nil, notSliceError("IntersectionBy", 2, slice2)
 	}
 	if sliceVal1.Type().Elem() != sliceVal2.Type().Elem() {
-		return nil, errors.New("godash: invalid parameter type. IntersectionBy func expects two slice parameters of the same type")
+		return nil, typeMismatchError("IntersectionBy", 2, reflect.SliceOf(sliceVal1.Type().Elem()), slice2)
+	}
+	if fn == nil {
+		return nil, nilFuncError("IntersectionBy", 3)
 	}
 
 	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice1).Elem()), 0, sliceVal1.Len())
-	m := make(map[interface{}]bool)
+	m := newValueMap(sliceVal2.Len())
 
 	for i := 0; i < sliceVal2.Len(); i++ {
 		item := sliceVal2.Index(i).Interface()
 		val := fn(item)
-		m[val] = false
+		m.add(val)
 	}
 
 	for i := 0; i < sliceVal1.Len(); i++ {
 		item := sliceVal1.Index(i).Interface()
 		val := fn(item)
-		appended, exists := m[val]
-		if exists {
-			if !appended {
-				dest = reflect.Append(dest, sliceVal1.Index(i))
+		// values present in the second slice are stored as 0, and set to 1 once appended
+		if appended, exists := m.get(val); exists && appended == 0 {
+			dest = reflect.Append(dest, sliceVal1.Index(i))
+			m.set(val, 1)
+		}
+	}
+	return dest.Interface(), nil
+
+}
+
+// IntersectionN creates a slice of unique values that were present in all of the provided slices.
+// The order of the items in the resulting slice is determined by the first given slice.
+// Elements that cannot be used as map keys, such as slices or maps, are compared with reflect.DeepEqual.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func IntersectionN(slices ...interface{}) (interface{}, error) {
+	return intersectionN("IntersectionN", nil, slices)
+}
+
+// IntersectionNBy passes items from all of the provided slices through a provided mutator function and creates a new slice with items that resulted in mutated values common to every slice.
+// The supplied mutator function must accept an interface{} parameter and return interface{} with the value to be compared.
+// The order and values of the items in the resulting slice are determined by the first given slice.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func IntersectionNBy(fn mutator, slices ...interface{}) (interface{}, error) {
+
+	if fn == nil {
+		return nil, nilFuncError("IntersectionNBy", 1)
+	}
+	return intersectionN("IntersectionNBy", fn, slices)
+
+}
+
+// intersectionN implements IntersectionN and IntersectionNBy. A nil fn compares the items themselves.
+func intersectionN(name string, fn mutator, slices []interface{}) (interface{}, error) {
+
+	if len(slices) == 0 {
+		return nil, &ArgumentError{Func: name, Param: 1, Expected: "a slice", Got: "no parameters", Err: ErrNotSlice}
+	}
+	sliceVals := make([]reflect.Value, len(slices))
+	for i, slice := range slices {
+		sliceVals[i] = reflect.ValueOf(slice)
+		if sliceVals[i].Kind() != reflect.Slice {
+			return nil, notSliceError(name, i+1, slice)
+		}
+		if sliceVals[i].Type().Elem() != sliceVals[0].Type().Elem() {
+			return nil, typeMismatchError(name, i+1, reflect.SliceOf(sliceVals[0].Type().Elem()), slice)
+		}
+	}
+	key := func(v reflect.Value) interface{} {
+		if fn == nil {
+			return v.Interface()
+		}
+		return fn(v.Interface())
+	}
+
+	// counts records how many of the subsequent slices, taken in order, each value has been seen in
+	counts := newValueMap(sliceVals[0].Len())
+	for i := 1; i < len(sliceVals); i++ {
+		for j := 0; j < sliceVals[i].Len(); j++ {
+			val := key(sliceVals[i].Index(j))
+			if count, _ := counts.get(val); count == i-1 {
+				counts.set(val, i)
 			}
-			m[val] = true
+		}
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(sliceVals[0].Type().Elem()), 0, sliceVals[0].Len())
+	for i := 0; i < sliceVals[0].Len(); i++ {
+		val := key(sliceVals[0].Index(i))
+		if count, _ := counts.get(val); count == len(sliceVals)-1 {
+			dest = reflect.Append(dest, sliceVals[0].Index(i))
+			counts.set(val, len(sliceVals))
 		}
 	}
 	return dest.Interface(), nil
@@ -36,6 +36,16 @@ func TestIntersection(t *testing.T) {
 		t.Errorf("Expected Intersection to return %v, but it returned %v", structExpected, structSlice)
 	}
 
+	// test unhashable success
+	nestedSlice, err := godash.Intersection([][]int{{1, 2}, {3}, {4, 5}, {1, 2}}, [][]int{{4, 5}, {1, 2}, {6}})
+	nestedExpected := [][]int{{1, 2}, {4, 5}}
+	if err != nil {
+		t.Errorf("Expected Intersection to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(nestedSlice, nestedExpected) {
+		t.Errorf("Expected Intersection to return %v, but it returned %v", nestedExpected, nestedSlice)
+	}
+
 	// test failure
 	result, err := godash.Intersection(1, []int{1, 2})
 	if err == nil {
@@ -97,6 +107,18 @@ func TestIntersectionBy(t *testing.T) {
 		t.Errorf("Expected IntersectionBy to return %v, but it returned %v", structExpected, structSlice)
 	}
 
+	// test unhashable mutated value success
+	fn = func(x interface{}) interface{} {
+		return []string{x.(str).name}
+	}
+	structSlice, err = godash.IntersectionBy(str1, str2, fn)
+	if err != nil {
+		t.Errorf("Expected IntersectionBy to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(structSlice, structExpected) {
+		t.Errorf("Expected IntersectionBy to return %v, but it returned %v", structExpected, structSlice)
+	}
+
 	// test failure
 	fn = func(x interface{}) interface{} {
 		return x
@@ -124,3 +146,80 @@ func TestIntersectionBy(t *testing.T) {
 	}
 
 }
+
+func TestIntersectionN(t *testing.T) {
+
+	// test int success
+	intSlice, err := godash.IntersectionN([]int{3, 17, 8, 11, 4, 8}, []int{11, 8, 5, 4}, []int{4, 8, 8}, []int{8, 1, 4})
+	intExpected := []int{8, 4}
+	if err != nil {
+		t.Errorf("Expected IntersectionN to return no error, but got %v", err)
+	}
+	if reflect.TypeOf(intSlice).Kind() != reflect.Slice {
+		t.Error("Expected IntersectionN to return slice")
+	}
+	if !reflect.DeepEqual(intSlice, intExpected) {
+		t.Errorf("Expected IntersectionN to return %v, but it returned %v", intExpected, intSlice)
+	}
+
+	// test single slice success
+	intSlice, err = godash.IntersectionN([]int{3, 17, 3})
+	intExpected = []int{3, 17}
+	if err != nil {
+		t.Errorf("Expected IntersectionN to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(intSlice, intExpected) {
+		t.Errorf("Expected IntersectionN to return %v, but it returned %v", intExpected, intSlice)
+	}
+
+	// test failure
+	result, err := godash.IntersectionN()
+	if err == nil {
+		t.Error("Expected IntersectionN to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected IntersectionN to return nil result, but got %v", result)
+	}
+	result, err = godash.IntersectionN([]int{1, 2}, []int{1, 2}, 1)
+	if err == nil {
+		t.Error("Expected IntersectionN to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected IntersectionN to return nil result, but got %v", result)
+	}
+	result, err = godash.IntersectionN([]int{1, 2}, []int{1, 2}, []float32{1, 2})
+	if err == nil {
+		t.Error("Expected IntersectionN to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected IntersectionN to return nil result, but got %v", result)
+	}
+
+}
+
+func TestIntersectionNBy(t *testing.T) {
+
+	fn := func(x interface{}) interface{} {
+		return math.Floor(x.(float64))
+	}
+
+	// test float success
+	floatSlice, err := godash.IntersectionNBy(fn, []float64{2.16, 1.23, 5.4}, []float64{5.78, 2.49, 3.7}, []float64{2.3, 5.1})
+	floatExpected := []float64{2.16, 5.4}
+	if err != nil {
+		t.Errorf("Expected IntersectionNBy to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(floatSlice, floatExpected) {
+		t.Errorf("Expected IntersectionNBy to return %v, but it returned %v", floatExpected, floatSlice)
+	}
+
+	// test failure
+	result, err := godash.IntersectionNBy(fn, []float64{1, 2}, 1.0)
+	if err == nil {
+		t.Error("Expected IntersectionNBy to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected IntersectionNBy to return nil result, but got %v", result)
+	}
+
+}
@@ -0,0 +1,148 @@
+package godash
+
+import (
+	"reflect"
+)
+
+// Map creates a new slice with the results of passing every element of the provided slice through a provided mutator function.
+// The supplied mutator function must accept an interface{} parameter and return interface{}.
+// The element type of the new slice is the type of the results if they all share the same type, otherwise it is interface{}.
+// Use MapTo to choose the element type explicitly, for example when the slice may be empty.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func Map(slice interface{}, fn mutator) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("Map", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("Map", 2)
+	}
+
+	results := make([]interface{}, sliceVal.Len())
+	for i := 0; i < sliceVal.Len(); i++ {
+		results[i] = fn(sliceVal.Index(i).Interface())
+	}
+	return typedSlice(results), nil
+
+}
+
+// MapTo creates a new slice of element type elemType with the results of passing every element of the provided slice through a provided mutator function.
+// The supplied mutator function must accept an interface{} parameter and return a value assignable to elemType.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func MapTo(slice interface{}, fn mutator, elemType reflect.Type) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("MapTo", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("MapTo", 2)
+	}
+	if elemType == nil {
+		return nil, &ArgumentError{Func: "MapTo", Param: 3, Expected: "a non-nil type", Got: "nil", Err: ErrTypeMismatch}
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(elemType), 0, sliceVal.Len())
+	for i := 0; i < sliceVal.Len(); i++ {
+		result := fn(sliceVal.Index(i).Interface())
+		resultVal, ok := assignableValue(result, elemType)
+		if !ok {
+			return nil, &ArgumentError{Func: "MapTo", Param: 2, Expected: "a function returning " + elemType.String(), Got: "a function returning " + typeName(result), Err: ErrTypeMismatch}
+		}
+		dest = reflect.Append(dest, resultVal)
+	}
+	return dest.Interface(), nil
+
+}
+
+// FlatMap creates a new slice by passing every element of the provided slice through a provided mutator function and concatenating the results.
+// The supplied mutator function must accept an interface{} parameter and return interface{}; results that are slices are
+// flattened one level, while any other result is added to the new slice as a single element.
+// If every result is a slice of the same type, the new slice has that type. Otherwise its element type is the type of
+// the collected elements if they all share the same type, or interface{} if they do not.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func FlatMap(slice interface{}, fn mutator) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("FlatMap", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("FlatMap", 2)
+	}
+
+	results := make([]reflect.Value, sliceVal.Len())
+	uniform := sliceVal.Len() > 0
+	for i := 0; i < sliceVal.Len(); i++ {
+		results[i] = reflect.ValueOf(fn(sliceVal.Index(i).Interface()))
+		uniform = uniform && results[i].Kind() == reflect.Slice && results[i].Type() == results[0].Type()
+	}
+
+	if uniform {
+		dest := reflect.MakeSlice(reflect.SliceOf(results[0].Type().Elem()), 0, len(results))
+		for _, resultVal := range results {
+			dest = reflect.AppendSlice(dest, resultVal)
+		}
+		return dest.Interface(), nil
+	}
+
+	items := []interface{}{}
+	for _, resultVal := range results {
+		switch {
+		case resultVal.Kind() == reflect.Slice:
+			for j := 0; j < resultVal.Len(); j++ {
+				items = append(items, resultVal.Index(j).Interface())
+			}
+		case resultVal.IsValid():
+			items = append(items, resultVal.Interface())
+		default:
+			items = append(items, nil)
+		}
+	}
+	return typedSlice(items), nil
+
+}
+
+// typedSlice returns items as a slice whose element type is the dynamic type shared by all of them,
+// or as a []interface{} if the items are of different types, include nil or there are none.
+func typedSlice(items []interface{}) interface{} {
+
+	var elemType reflect.Type
+	for _, item := range items {
+		t := reflect.TypeOf(item)
+		if t == nil || (elemType != nil && t != elemType) {
+			return items
+		}
+		elemType = t
+	}
+	if elemType == nil {
+		return items
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(elemType), 0, len(items))
+	for _, item := range items {
+		dest = reflect.Append(dest, reflect.ValueOf(item))
+	}
+	return dest.Interface()
+
+}
+
+// assignableValue returns v as a reflect.Value that can be stored in an element of type t,
+// reporting false if v is not assignable to t. A nil v becomes the zero value of t if t can be nil.
+func assignableValue(v interface{}, t reflect.Type) (reflect.Value, bool) {
+
+	if v == nil {
+		switch t.Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
+			return reflect.Zero(t), true
+		}
+		return reflect.Value{}, false
+	}
+	val := reflect.ValueOf(v)
+	if !val.Type().AssignableTo(t) {
+		return reflect.Value{}, false
+	}
+	return val, true
+
+}
@@ -0,0 +1,138 @@
+package godash_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestMap(t *testing.T) {
+
+	// test for inferred type success
+	nameSlice, err := godash.Map([]str{{name: "first"}, {name: "second"}}, func(x interface{}) interface{} {
+		return x.(str).name
+	})
+	nameExpected := []string{"first", "second"}
+	if err != nil {
+		t.Errorf("Expected Map to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(nameSlice, nameExpected) {
+		t.Errorf("Expected Map to return %v, but it returned %v", nameExpected, nameSlice)
+	}
+
+	// test for mixed type success
+	mixedSlice, err := godash.Map([]int{1, 2, 3}, func(x interface{}) interface{} {
+		if x.(int)%2 == 0 {
+			return "even"
+		}
+		return x
+	})
+	mixedExpected := []interface{}{1, "even", 3}
+	if err != nil {
+		t.Errorf("Expected Map to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(mixedSlice, mixedExpected) {
+		t.Errorf("Expected Map to return %v, but it returned %v", mixedExpected, mixedSlice)
+	}
+
+	// test for empty slice
+	emptySlice, err := godash.Map([]int{}, func(x interface{}) interface{} { return x })
+	if err != nil {
+		t.Errorf("Expected Map to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(emptySlice, []interface{}{}) {
+		t.Errorf("Expected Map to return empty slice, but it returned %v", emptySlice)
+	}
+
+	// test for failure
+	fail, err := godash.Map(1, func(x interface{}) interface{} { return x })
+	if err == nil {
+		t.Error("Expected Map to return error")
+	}
+	if fail != nil {
+		t.Errorf("Expected Map to return nil result, but got %v", fail)
+	}
+
+}
+
+func TestMapTo(t *testing.T) {
+
+	fn := func(x interface{}) interface{} {
+		if x.(int) == 0 {
+			return nil
+		}
+		return errors.New("failed")
+	}
+
+	// test for success
+	errorSlice, err := godash.MapTo([]int{1, 0}, fn, reflect.TypeOf((*error)(nil)).Elem())
+	if err != nil {
+		t.Errorf("Expected MapTo to return no error, but got %v", err)
+	}
+	errs, ok := errorSlice.([]error)
+	if !ok || len(errs) != 2 || errs[0] == nil || errs[1] != nil {
+		t.Errorf("Expected MapTo to return []error{failed, nil}, but it returned %#v", errorSlice)
+	}
+
+	// test for empty slice
+	emptySlice, err := godash.MapTo([]int{}, fn, reflect.TypeOf(""))
+	if err != nil {
+		t.Errorf("Expected MapTo to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(emptySlice, []string{}) {
+		t.Errorf("Expected MapTo to return empty string slice, but it returned %#v", emptySlice)
+	}
+
+	// test for failure
+	fail, err := godash.MapTo([]int{1}, fn, reflect.TypeOf(""))
+	if !errors.Is(err, godash.ErrTypeMismatch) {
+		t.Errorf("Expected MapTo to return ErrTypeMismatch, but got %v", err)
+	}
+	if fail != nil {
+		t.Errorf("Expected MapTo to return nil result, but got %v", fail)
+	}
+
+}
+
+func TestFlatMap(t *testing.T) {
+
+	// test for slice results success
+	wordSlice, err := godash.FlatMap([]string{"a b", "c", ""}, func(x interface{}) interface{} {
+		return strings.Fields(x.(string))
+	})
+	wordExpected := []string{"a", "b", "c"}
+	if err != nil {
+		t.Errorf("Expected FlatMap to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(wordSlice, wordExpected) {
+		t.Errorf("Expected FlatMap to return %v, but it returned %v", wordExpected, wordSlice)
+	}
+
+	// test for mixed results success
+	intSlice, err := godash.FlatMap([]int{1, 2, 3}, func(x interface{}) interface{} {
+		if x.(int)%2 == 0 {
+			return x
+		}
+		return []int{x.(int), x.(int)}
+	})
+	intExpected := []int{1, 1, 2, 3, 3}
+	if err != nil {
+		t.Errorf("Expected FlatMap to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(intSlice, intExpected) {
+		t.Errorf("Expected FlatMap to return %v, but it returned %v", intExpected, intSlice)
+	}
+
+	// test for failure
+	fail, err := godash.FlatMap([]int{1}, nil)
+	if err == nil {
+		t.Error("Expected FlatMap to return error")
+	}
+	if fail != nil {
+		t.Errorf("Expected FlatMap to return nil result, but got %v", fail)
+	}
+
+}
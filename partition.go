@@ -0,0 +1,32 @@
+package godash
+
+import (
+	"reflect"
+)
+
+// Partition splits the provided slice into the elements that the provided validator function returns true for and the elements it returns false for.
+// The supplied function must accept an interface{} parameter and return bool, and is called once per element.
+// Both new slices keep the order of the given slice, and are returned as interface{} values that may need to have a type assertion applied to them afterwards.
+func Partition(slice interface{}, fn validator) (interface{}, interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, nil, notSliceError("Partition", 1, slice)
+	}
+	if fn == nil {
+		return nil, nil, nilFuncError("Partition", 2)
+	}
+
+	matched := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice).Elem()), 0, sliceVal.Len())
+	rest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice).Elem()), 0, sliceVal.Len())
+
+	for i := 0; i < sliceVal.Len(); i++ {
+		if match := fn(sliceVal.Index(i).Interface()); match {
+			matched = reflect.Append(matched, sliceVal.Index(i))
+		} else {
+			rest = reflect.Append(rest, sliceVal.Index(i))
+		}
+	}
+	return matched.Interface(), rest.Interface(), nil
+
+}
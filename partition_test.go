@@ -0,0 +1,45 @@
+package godash_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestPartition(t *testing.T) {
+
+	calls := 0
+	fn := func(x interface{}) bool {
+		calls++
+		return x.(str).foo != ""
+	}
+
+	// test for success
+	source := []str{{name: "first", foo: "ok"}, {name: "second"}, {name: "third", foo: "ok"}, {name: "fourth"}}
+	matched, rest, err := godash.Partition(source, fn)
+	matchedExpected := []str{{name: "first", foo: "ok"}, {name: "third", foo: "ok"}}
+	restExpected := []str{{name: "second"}, {name: "fourth"}}
+	if err != nil {
+		t.Errorf("Expected Partition to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(matched, matchedExpected) {
+		t.Errorf("Expected Partition to return matched %v, but it returned %v", matchedExpected, matched)
+	}
+	if !reflect.DeepEqual(rest, restExpected) {
+		t.Errorf("Expected Partition to return rest %v, but it returned %v", restExpected, rest)
+	}
+	if calls != len(source) {
+		t.Errorf("Expected Partition to call validator %v times, but it called it %v times", len(source), calls)
+	}
+
+	// test for failure
+	matched, rest, err = godash.Partition(str{name: "first"}, fn)
+	if err == nil {
+		t.Error("Expected Partition to return error")
+	}
+	if matched != nil || rest != nil {
+		t.Errorf("Expected Partition to return nil results, but got %v and %v", matched, rest)
+	}
+
+}
@@ -0,0 +1,86 @@
+package godash
+
+import (
+	"reflect"
+)
+
+// Every reports whether the provided validator function returns true for every element of the slice.
+// The supplied function must accept an interface{} parameter and return bool.
+// It stops at the first element the validator function returns false for. An empty slice results in true.
+func Every(slice interface{}, fn validator) (bool, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return false, notSliceError("Every", 1, slice)
+	}
+	if fn == nil {
+		return false, nilFuncError("Every", 2)
+	}
+
+	for i := 0; i < sliceVal.Len(); i++ {
+		if match := fn(sliceVal.Index(i).Interface()); !match {
+			return false, nil
+		}
+	}
+	return true, nil
+
+}
+
+// Some reports whether the provided validator function returns true for at least one element of the slice.
+// The supplied function must accept an interface{} parameter and return bool.
+// It stops at the first element the validator function returns true for. An empty slice results in false.
+func Some(slice interface{}, fn validator) (bool, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return false, notSliceError("Some", 1, slice)
+	}
+	if fn == nil {
+		return false, nilFuncError("Some", 2)
+	}
+
+	for i := 0; i < sliceVal.Len(); i++ {
+		if match := fn(sliceVal.Index(i).Interface()); match {
+			return true, nil
+		}
+	}
+	return false, nil
+
+}
+
+// None reports whether the provided validator function returns false for every element of the slice.
+// The supplied function must accept an interface{} parameter and return bool.
+// It stops at the first element the validator function returns true for. An empty slice results in true.
+func None(slice interface{}, fn validator) (bool, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return false, notSliceError("None", 1, slice)
+	}
+	if fn == nil {
+		return false, nilFuncError("None", 2)
+	}
+
+	for i := 0; i < sliceVal.Len(); i++ {
+		if match := fn(sliceVal.Index(i).Interface()); match {
+			return false, nil
+		}
+	}
+	return true, nil
+
+}
+
+// Includes reports whether the slice holds an element that equals the provided value, using the same comparison as FindIndex.
+func Includes(slice interface{}, value interface{}) (bool, error) {
+
+	if reflect.ValueOf(slice).Kind() != reflect.Slice {
+		return false, notSliceError("Includes", 1, slice)
+	}
+
+	index, err := FindIndex(slice, value)
+	if err != nil {
+		return false, err
+	}
+	return index != -1, nil
+
+}
@@ -0,0 +1,165 @@
+package godash_test
+
+import (
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestEvery(t *testing.T) {
+
+	calls := 0
+	fn := func(x interface{}) bool {
+		calls++
+		return x.(int) > 0
+	}
+
+	// test for success
+	result, err := godash.Every([]int{1, 2, 3}, fn)
+	if err != nil {
+		t.Errorf("Expected Every to return no error, but got %v", err)
+	}
+	if !result {
+		t.Error("Expected Every to return true")
+	}
+
+	// test for short circuit
+	calls = 0
+	result, err = godash.Every([]int{1, -2, 3}, fn)
+	if err != nil {
+		t.Errorf("Expected Every to return no error, but got %v", err)
+	}
+	if result {
+		t.Error("Expected Every to return false")
+	}
+	if calls != 2 {
+		t.Errorf("Expected Every to stop after %v calls, but it made %v", 2, calls)
+	}
+
+	// test for empty slice
+	result, err = godash.Every([]int{}, fn)
+	if err != nil {
+		t.Errorf("Expected Every to return no error, but got %v", err)
+	}
+	if !result {
+		t.Error("Expected Every to return true")
+	}
+
+	// test for failure
+	result, err = godash.Every(1, fn)
+	if err == nil {
+		t.Error("Expected Every to return error")
+	}
+	if result {
+		t.Error("Expected Every to return false")
+	}
+
+}
+
+func TestSome(t *testing.T) {
+
+	calls := 0
+	fn := func(x interface{}) bool {
+		calls++
+		return x.(int) < 0
+	}
+
+	// test for success
+	result, err := godash.Some([]int{1, -2, 3}, fn)
+	if err != nil {
+		t.Errorf("Expected Some to return no error, but got %v", err)
+	}
+	if !result {
+		t.Error("Expected Some to return true")
+	}
+	if calls != 2 {
+		t.Errorf("Expected Some to stop after %v calls, but it made %v", 2, calls)
+	}
+
+	// test for no match
+	result, err = godash.Some([]int{1, 2, 3}, fn)
+	if err != nil {
+		t.Errorf("Expected Some to return no error, but got %v", err)
+	}
+	if result {
+		t.Error("Expected Some to return false")
+	}
+
+	// test for failure
+	result, err = godash.Some([]int{1}, nil)
+	if err == nil {
+		t.Error("Expected Some to return error")
+	}
+	if result {
+		t.Error("Expected Some to return false")
+	}
+
+}
+
+func TestNone(t *testing.T) {
+
+	fn := func(x interface{}) bool {
+		return x.(str).name == ""
+	}
+
+	// test for success
+	result, err := godash.None([]str{{name: "first"}, {name: "second"}}, fn)
+	if err != nil {
+		t.Errorf("Expected None to return no error, but got %v", err)
+	}
+	if !result {
+		t.Error("Expected None to return true")
+	}
+
+	// test for match
+	result, err = godash.None([]str{{name: "first"}, {}}, fn)
+	if err != nil {
+		t.Errorf("Expected None to return no error, but got %v", err)
+	}
+	if result {
+		t.Error("Expected None to return false")
+	}
+
+	// test for failure
+	result, err = godash.None(str{}, fn)
+	if err == nil {
+		t.Error("Expected None to return error")
+	}
+	if result {
+		t.Error("Expected None to return false")
+	}
+
+}
+
+func TestIncludes(t *testing.T) {
+
+	structSource := []str{{name: "first"}, {name: "second"}}
+
+	// test for success
+	result, err := godash.Includes(structSource, str{name: "second"})
+	if err != nil {
+		t.Errorf("Expected Includes to return no error, but got %v", err)
+	}
+	if !result {
+		t.Error("Expected Includes to return true")
+	}
+
+	// test for not found
+	result, err = godash.Includes(structSource, str{name: "third"})
+	if err != nil {
+		t.Errorf("Expected Includes to return no error, but got %v", err)
+	}
+	if result {
+		t.Error("Expected Includes to return false")
+	}
+
+	// test for failure
+	result, err = godash.Includes(nil, 1)
+	if err == nil {
+		t.Error("Expected Includes to return error")
+	}
+	if result {
+		t.Error("Expected Includes to return false")
+	}
+
+}
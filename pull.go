@@ -0,0 +1,74 @@
+package godash
+
+import (
+	"reflect"
+)
+
+// Pull removes values from the slice that slicePtr points to, modifying it in place, and returns the removed elements.
+// It accepts a pointer to a slice of any type as the first parameter, followed by a list of parameter values to remove from the slice.
+// The additional values must be of the same type as the elements of the slice, and are compared like in Without.
+// The remaining elements are compacted into the existing backing array, keeping their order, so no new slice is allocated for them.
+// The removed elements are returned as an interface{} and may need to have a type assertion applied to them afterwards.
+func Pull(slicePtr interface{}, values ...interface{}) (interface{}, error) {
+
+	ptrVal := reflect.ValueOf(slicePtr)
+	if ptrVal.Kind() != reflect.Ptr || ptrVal.IsNil() || ptrVal.Elem().Kind() != reflect.Slice {
+		return nil, notSlicePointerError("Pull", 1, slicePtr)
+	}
+	sliceVal := ptrVal.Elem()
+	for i, v := range values {
+		if sliceVal.Type().Elem() != reflect.TypeOf(v) {
+			return nil, typeMismatchError("Pull", i+2, sliceVal.Type().Elem(), v)
+		}
+	}
+
+	return compact(sliceVal, valueMatcher(sliceVal.Type().Elem(), values)), nil
+
+}
+
+// Remove removes the elements for which the provided validator function returns true from the slice that slicePtr points to,
+// modifying it in place, and returns the removed elements.
+// The supplied function must accept an interface{} parameter and return bool.
+// The remaining elements are compacted into the existing backing array, keeping their order, so no new slice is allocated for them.
+// The removed elements are returned as an interface{} and may need to have a type assertion applied to them afterwards.
+func Remove(slicePtr interface{}, fn validator) (interface{}, error) {
+
+	ptrVal := reflect.ValueOf(slicePtr)
+	if ptrVal.Kind() != reflect.Ptr || ptrVal.IsNil() || ptrVal.Elem().Kind() != reflect.Slice {
+		return nil, notSlicePointerError("Remove", 1, slicePtr)
+	}
+	if fn == nil {
+		return nil, nilFuncError("Remove", 2)
+	}
+
+	return compact(ptrVal.Elem(), fn), nil
+
+}
+
+// compact moves the elements of the addressable slice sliceVal for which remove returns false to the front,
+// shortens the slice to hold only those and returns the other elements in a new slice.
+func compact(sliceVal reflect.Value, remove func(interface{}) bool) interface{} {
+
+	removed := reflect.MakeSlice(reflect.SliceOf(sliceVal.Type().Elem()), 0, 0)
+	n := 0
+
+	for i := 0; i < sliceVal.Len(); i++ {
+		if remove(sliceVal.Index(i).Interface()) {
+			removed = reflect.Append(removed, sliceVal.Index(i))
+			continue
+		}
+		if i != n {
+			sliceVal.Index(n).Set(sliceVal.Index(i))
+		}
+		n++
+	}
+
+	// clear the vacated tail so removed elements are not kept alive by the backing array
+	zero := reflect.Zero(sliceVal.Type().Elem())
+	for i := n; i < sliceVal.Len(); i++ {
+		sliceVal.Index(i).Set(zero)
+	}
+	sliceVal.SetLen(n)
+	return removed.Interface()
+
+}
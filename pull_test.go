@@ -0,0 +1,106 @@
+package godash_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestPull(t *testing.T) {
+
+	// test for int success
+	intSource := []int{1, 2, 3, 4, 2, 5}
+	backing := &intSource[0]
+	removed, err := godash.Pull(&intSource, 2, 4)
+	intExpected := []int{1, 3, 5}
+	removedExpected := []int{2, 4, 2}
+	if err != nil {
+		t.Errorf("Expected Pull to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(intSource, intExpected) {
+		t.Errorf("Expected Pull to leave %v, but it left %v", intExpected, intSource)
+	}
+	if !reflect.DeepEqual(removed, removedExpected) {
+		t.Errorf("Expected Pull to return %v, but it returned %v", removedExpected, removed)
+	}
+	if &intSource[0] != backing {
+		t.Error("Expected Pull to reuse the backing array")
+	}
+
+	// test for struct success
+	structSource := []str{{name: "first"}, {name: "second"}, {name: "third"}}
+	removed, err = godash.Pull(&structSource, str{name: "second"})
+	structExpected := []str{{name: "first"}, {name: "third"}}
+	if err != nil {
+		t.Errorf("Expected Pull to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(structSource, structExpected) {
+		t.Errorf("Expected Pull to leave %v, but it left %v", structExpected, structSource)
+	}
+	if !reflect.DeepEqual(removed, []str{{name: "second"}}) {
+		t.Errorf("Expected Pull to return %v, but it returned %v", []str{{name: "second"}}, removed)
+	}
+
+	// test for failure
+	removed, err = godash.Pull(intSource, 1)
+	if !errors.Is(err, godash.ErrNotSlice) {
+		t.Errorf("Expected Pull to return ErrNotSlice, but got %v", err)
+	}
+	if removed != nil {
+		t.Errorf("Expected Pull to return nil result, but got %v", removed)
+	}
+	removed, err = godash.Pull(&intSource, "one")
+	if !errors.Is(err, godash.ErrTypeMismatch) {
+		t.Errorf("Expected Pull to return ErrTypeMismatch, but got %v", err)
+	}
+	if removed != nil {
+		t.Errorf("Expected Pull to return nil result, but got %v", removed)
+	}
+
+}
+
+func TestRemove(t *testing.T) {
+
+	fn := func(x interface{}) bool {
+		return x.(int)%2 == 0
+	}
+
+	// test for success
+	source := []int{1, 2, 3, 4, 5, 6}
+	full := source[:cap(source)]
+	removed, err := godash.Remove(&source, fn)
+	expected := []int{1, 3, 5}
+	removedExpected := []int{2, 4, 6}
+	if err != nil {
+		t.Errorf("Expected Remove to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(source, expected) {
+		t.Errorf("Expected Remove to leave %v, but it left %v", expected, source)
+	}
+	if !reflect.DeepEqual(removed, removedExpected) {
+		t.Errorf("Expected Remove to return %v, but it returned %v", removedExpected, removed)
+	}
+	if !reflect.DeepEqual(full, []int{1, 3, 5, 0, 0, 0}) {
+		t.Errorf("Expected Remove to compact in place and clear the tail, but backing array is %v", full)
+	}
+
+	// test for failure
+	var nilPtr *[]int
+	removed, err = godash.Remove(nilPtr, fn)
+	if !errors.Is(err, godash.ErrNotSlice) {
+		t.Errorf("Expected Remove to return ErrNotSlice, but got %v", err)
+	}
+	if removed != nil {
+		t.Errorf("Expected Remove to return nil result, but got %v", removed)
+	}
+	removed, err = godash.Remove(&source, nil)
+	if !errors.Is(err, godash.ErrNilFunc) {
+		t.Errorf("Expected Remove to return ErrNilFunc, but got %v", err)
+	}
+	if removed != nil {
+		t.Errorf("Expected Remove to return nil result, but got %v", removed)
+	}
+
+}
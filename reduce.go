@@ -0,0 +1,69 @@
+package godash
+
+import (
+	"reflect"
+)
+
+// Reduce combines the elements of the slice into a single value by calling the provided reducer function for each element from first to last.
+// The supplied function must accept the accumulated value and an element, both as interface{}, and return the new accumulated value.
+// The first call receives initial as the accumulated value. If the slice is empty, initial is returned.
+func Reduce(slice interface{}, fn reducer, initial interface{}) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("Reduce", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("Reduce", 2)
+	}
+
+	acc := initial
+	for i := 0; i < sliceVal.Len(); i++ {
+		acc = fn(acc, sliceVal.Index(i).Interface())
+	}
+	return acc, nil
+
+}
+
+// ReduceRight is like Reduce, except that it visits the elements of the slice from last to first.
+func ReduceRight(slice interface{}, fn reducer, initial interface{}) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("ReduceRight", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("ReduceRight", 2)
+	}
+
+	acc := initial
+	for i := sliceVal.Len() - 1; i != -1; i-- {
+		acc = fn(acc, sliceVal.Index(i).Interface())
+	}
+	return acc, nil
+
+}
+
+// Fold is like Reduce, except that the provided function can stop the iteration early.
+// The supplied function must accept the accumulated value and an element, both as interface{}, and return the new
+// accumulated value along with a bool. Returning true stops the iteration, and the value returned with it is the result.
+func Fold(slice interface{}, fn folder, initial interface{}) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("Fold", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("Fold", 2)
+	}
+
+	acc := initial
+	for i := 0; i < sliceVal.Len(); i++ {
+		var stop bool
+		if acc, stop = fn(acc, sliceVal.Index(i).Interface()); stop {
+			break
+		}
+	}
+	return acc, nil
+
+}
@@ -0,0 +1,121 @@
+package godash_test
+
+import (
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestReduce(t *testing.T) {
+
+	sumFn := func(acc interface{}, x interface{}) interface{} {
+		return acc.(int) + x.(int)
+	}
+	concatFn := func(acc interface{}, x interface{}) interface{} {
+		return acc.(string) + x.(str).name
+	}
+
+	// test for int success
+	sum, err := godash.Reduce([]int{1, 2, 3, 4}, sumFn, 10)
+	if err != nil {
+		t.Errorf("Expected Reduce to return no error, but got %v", err)
+	}
+	if sum != 20 {
+		t.Errorf("Expected Reduce to return %v, but it returned %v", 20, sum)
+	}
+
+	// test for struct success
+	names, err := godash.Reduce([]str{{name: "a"}, {name: "b"}, {name: "c"}}, concatFn, "")
+	if err != nil {
+		t.Errorf("Expected Reduce to return no error, but got %v", err)
+	}
+	if names != "abc" {
+		t.Errorf("Expected Reduce to return %v, but it returned %v", "abc", names)
+	}
+
+	// test for empty slice
+	sum, err = godash.Reduce([]int{}, sumFn, 10)
+	if err != nil {
+		t.Errorf("Expected Reduce to return no error, but got %v", err)
+	}
+	if sum != 10 {
+		t.Errorf("Expected Reduce to return %v, but it returned %v", 10, sum)
+	}
+
+	// test for failure
+	sum, err = godash.Reduce(1, sumFn, 0)
+	if err == nil {
+		t.Error("Expected Reduce to return error")
+	}
+	if sum != nil {
+		t.Errorf("Expected Reduce to return nil result, but got %v", sum)
+	}
+
+}
+
+func TestReduceRight(t *testing.T) {
+
+	concatFn := func(acc interface{}, x interface{}) interface{} {
+		return acc.(string) + x.(string)
+	}
+
+	// test for success
+	result, err := godash.ReduceRight([]string{"a", "b", "c"}, concatFn, "")
+	if err != nil {
+		t.Errorf("Expected ReduceRight to return no error, but got %v", err)
+	}
+	if result != "cba" {
+		t.Errorf("Expected ReduceRight to return %v, but it returned %v", "cba", result)
+	}
+
+	// test for failure
+	result, err = godash.ReduceRight([]string{"a"}, nil, "")
+	if err == nil {
+		t.Error("Expected ReduceRight to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected ReduceRight to return nil result, but got %v", result)
+	}
+
+}
+
+func TestFold(t *testing.T) {
+
+	calls := 0
+	fn := func(acc interface{}, x interface{}) (interface{}, bool) {
+		calls++
+		sum := acc.(int) + x.(int)
+		return sum, sum > 5
+	}
+
+	// test for early termination
+	sum, err := godash.Fold([]int{1, 2, 3, 4, 5}, fn, 0)
+	if err != nil {
+		t.Errorf("Expected Fold to return no error, but got %v", err)
+	}
+	if sum != 6 {
+		t.Errorf("Expected Fold to return %v, but it returned %v", 6, sum)
+	}
+	if calls != 3 {
+		t.Errorf("Expected Fold to stop after %v calls, but it made %v", 3, calls)
+	}
+
+	// test for full iteration
+	sum, err = godash.Fold([]int{1, 2}, fn, 0)
+	if err != nil {
+		t.Errorf("Expected Fold to return no error, but got %v", err)
+	}
+	if sum != 3 {
+		t.Errorf("Expected Fold to return %v, but it returned %v", 3, sum)
+	}
+
+	// test for failure
+	sum, err = godash.Fold(str{name: "one"}, fn, 0)
+	if err == nil {
+		t.Error("Expected Fold to return error")
+	}
+	if sum != nil {
+		t.Errorf("Expected Fold to return nil result, but got %v", sum)
+	}
+
+}
@@ -0,0 +1,215 @@
+package godash
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SortDirection determines whether OrderBy sorts by a key in ascending or descending order.
+type SortDirection int
+
+const (
+	// Ascending sorts smaller keys first.
+	Ascending SortDirection = iota
+	// Descending sorts larger keys first.
+	Descending
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// SortBy creates a new slice with the elements of the provided slice sorted in ascending order of the keys returned by the provided mutator functions.
+// Each supplied mutator function must accept an interface{} parameter and return an int, uint, float, string or time.Time key, using the same kind of key for every element.
+// Elements are compared by the key of the first function, ties are broken by the key of the next function, and so on.
+// The sort is stable, so elements with equal keys keep their original order. The given slice is not modified.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func SortBy(slice interface{}, fns ...mutator) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("SortBy", 1, slice)
+	}
+	for i, fn := range fns {
+		if fn == nil {
+			return nil, nilFuncError("SortBy", i+2)
+		}
+	}
+
+	return sortBy("SortBy", sliceVal, fns, nil)
+
+}
+
+// OrderBy is like SortBy, except that each key can be sorted in ascending or descending order.
+// The keys and directions slices must have the same length; directions[i] determines the order of the key returned by keys[i].
+func OrderBy(slice interface{}, keys []func(interface{}) interface{}, directions []SortDirection) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("OrderBy", 1, slice)
+	}
+	fns := make([]mutator, len(keys))
+	for i, fn := range keys {
+		if fn == nil {
+			return nil, &ArgumentError{Func: "OrderBy", Param: 2, Expected: "non-nil key functions", Got: "a nil function", Err: ErrNilFunc}
+		}
+		fns[i] = fn
+	}
+	if len(directions) != len(keys) {
+		return nil, &ArgumentError{Func: "OrderBy", Param: 3, Expected: fmt.Sprintf("%d directions", len(keys)), Got: strconv.Itoa(len(directions)), Err: ErrLengthMismatch}
+	}
+
+	return sortBy("OrderBy", sliceVal, fns, directions)
+
+}
+
+// sortBy implements SortBy and OrderBy on behalf of function name. A nil directions sorts every key in ascending order.
+func sortBy(name string, sliceVal reflect.Value, fns []mutator, directions []SortDirection) (interface{}, error) {
+
+	keys := make([][]interface{}, len(fns))
+	compares := make([]func(a, b interface{}) int, len(fns))
+	for k, fn := range fns {
+		keys[k] = make([]interface{}, sliceVal.Len())
+		for i := range keys[k] {
+			keys[k][i] = fn(sliceVal.Index(i).Interface())
+		}
+		compare, ok := keyComparer(keys[k])
+		if !ok {
+			param := k + 2
+			if directions != nil {
+				param = 2
+			}
+			return nil, &ArgumentError{Func: name, Param: param, Expected: "a function returning int, uint, float, string or time.Time keys of one kind", Got: "a function returning " + keyTypeNames(keys[k]), Err: ErrTypeMismatch}
+		}
+		compares[k] = compare
+	}
+
+	order := make([]int, sliceVal.Len())
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(x, y int) bool {
+		for k, compare := range compares {
+			c := compare(keys[k][order[x]], keys[k][order[y]])
+			if directions != nil && directions[k] == Descending {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+
+	dest := reflect.MakeSlice(reflect.SliceOf(sliceVal.Type().Elem()), 0, sliceVal.Len())
+	for _, i := range order {
+		dest = reflect.Append(dest, sliceVal.Index(i))
+	}
+	return dest.Interface(), nil
+
+}
+
+// keyComparer returns a function comparing two of the provided keys, as described in kindComparer.
+// It reports false if the keys are not all of one supported kind.
+func keyComparer(keys []interface{}) (func(a, b interface{}) int, bool) {
+
+	if len(keys) == 0 {
+		return nil, true
+	}
+	kind := keyKind(keys[0])
+	if kind == reflect.Invalid {
+		return nil, false
+	}
+	for _, key := range keys[1:] {
+		if keyKind(key) != kind {
+			return nil, false
+		}
+	}
+
+	return kindComparer(kind), true
+
+}
+
+// kindComparer returns a function comparing two sort keys of the provided kind, as classified by keyKind.
+// The function returns a negative number, zero or a positive number when the first key is smaller than, equal to or larger than the second.
+// NaN float keys are treated as smaller than any other float.
+func kindComparer(kind reflect.Kind) func(a, b interface{}) int {
+
+	switch kind {
+	case reflect.Int:
+		return func(a, b interface{}) int {
+			x, y := reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int()
+			return boolToInt(x > y) - boolToInt(x < y)
+		}
+	case reflect.Uint:
+		return func(a, b interface{}) int {
+			x, y := reflect.ValueOf(a).Uint(), reflect.ValueOf(b).Uint()
+			return boolToInt(x > y) - boolToInt(x < y)
+		}
+	case reflect.Float64:
+		return func(a, b interface{}) int {
+			x, y := reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float()
+			if x != x || y != y {
+				// order NaNs first, consistently with sort.Float64Slice
+				return boolToInt(y != y) - boolToInt(x != x)
+			}
+			return boolToInt(x > y) - boolToInt(x < y)
+		}
+	case reflect.String:
+		return func(a, b interface{}) int {
+			return strings.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String())
+		}
+	default:
+		return func(a, b interface{}) int {
+			return a.(time.Time).Compare(b.(time.Time))
+		}
+	}
+
+}
+
+// keyKind classifies a sort key, folding the sized int, uint and float kinds into reflect.Int, reflect.Uint and reflect.Float64,
+// and time.Time into reflect.Struct. Unsupported keys are reported as reflect.Invalid.
+func keyKind(key interface{}) reflect.Kind {
+
+	val := reflect.ValueOf(key)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.Uint
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	case reflect.String:
+		return reflect.String
+	case reflect.Struct:
+		if val.Type() == timeType {
+			return reflect.Struct
+		}
+	}
+	return reflect.Invalid
+
+}
+
+// keyTypeNames describes the distinct types of the provided keys for use in error messages.
+func keyTypeNames(keys []interface{}) string {
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		if name := typeName(key); !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, " and ")
+
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,124 @@
+package godash_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zillow/godash"
+)
+
+type listing struct {
+	city   string
+	price  float64
+	listed time.Time
+}
+
+func TestSortBy(t *testing.T) {
+
+	cityFn := func(x interface{}) interface{} {
+		return x.(listing).city
+	}
+	priceFn := func(x interface{}) interface{} {
+		return x.(listing).price
+	}
+	day := func(d int) time.Time {
+		return time.Date(2020, 1, d, 0, 0, 0, 0, time.UTC)
+	}
+	source := []listing{
+		{city: "Seattle", price: 500, listed: day(3)},
+		{city: "Denver", price: 300, listed: day(1)},
+		{city: "Seattle", price: 200, listed: day(2)},
+		{city: "Denver", price: 300, listed: day(4)},
+	}
+
+	// test for multiple keys
+	sorted, err := godash.SortBy(source, cityFn, priceFn)
+	expected := []listing{source[1], source[3], source[2], source[0]}
+	if err != nil {
+		t.Errorf("Expected SortBy to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("Expected SortBy to return %v, but it returned %v", expected, sorted)
+	}
+	if source[0].city != "Seattle" || source[1].city != "Denver" {
+		t.Errorf("Expected SortBy to leave the source unchanged, but it is %v", source)
+	}
+
+	// test for time keys
+	sorted, err = godash.SortBy(source, func(x interface{}) interface{} { return x.(listing).listed })
+	expected = []listing{source[1], source[2], source[0], source[3]}
+	if err != nil {
+		t.Errorf("Expected SortBy to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("Expected SortBy to return %v, but it returned %v", expected, sorted)
+	}
+
+	// test for float keys with NaN
+	floats, err := godash.SortBy([]float64{2.5, math.NaN(), -1, 0}, func(x interface{}) interface{} { return x })
+	if err != nil {
+		t.Errorf("Expected SortBy to return no error, but got %v", err)
+	}
+	if f := floats.([]float64); !math.IsNaN(f[0]) || f[1] != -1 || f[2] != 0 || f[3] != 2.5 {
+		t.Errorf("Expected SortBy to return [NaN -1 0 2.5], but it returned %v", f)
+	}
+
+	// test for failure
+	fail, err := godash.SortBy([]int{1, 2}, func(x interface{}) interface{} {
+		if x.(int) == 1 {
+			return "one"
+		}
+		return x
+	})
+	if !errors.Is(err, godash.ErrTypeMismatch) {
+		t.Errorf("Expected SortBy to return ErrTypeMismatch, but got %v", err)
+	}
+	if fail != nil {
+		t.Errorf("Expected SortBy to return nil result, but got %v", fail)
+	}
+	fail, err = godash.SortBy([][]int{{1}, {2}}, func(x interface{}) interface{} { return x })
+	if !errors.Is(err, godash.ErrTypeMismatch) {
+		t.Errorf("Expected SortBy to return ErrTypeMismatch, but got %v", err)
+	}
+	if fail != nil {
+		t.Errorf("Expected SortBy to return nil result, but got %v", fail)
+	}
+
+}
+
+func TestOrderBy(t *testing.T) {
+
+	source := []listing{
+		{city: "Seattle", price: 500},
+		{city: "Denver", price: 300},
+		{city: "Seattle", price: 200},
+		{city: "Denver", price: 400},
+	}
+	keys := []func(interface{}) interface{}{
+		func(x interface{}) interface{} { return x.(listing).city },
+		func(x interface{}) interface{} { return x.(listing).price },
+	}
+
+	// test for mixed directions
+	sorted, err := godash.OrderBy(source, keys, []godash.SortDirection{godash.Ascending, godash.Descending})
+	expected := []listing{source[3], source[1], source[0], source[2]}
+	if err != nil {
+		t.Errorf("Expected OrderBy to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("Expected OrderBy to return %v, but it returned %v", expected, sorted)
+	}
+
+	// test for failure
+	fail, err := godash.OrderBy(source, keys, []godash.SortDirection{godash.Descending})
+	if !errors.Is(err, godash.ErrLengthMismatch) {
+		t.Errorf("Expected OrderBy to return ErrLengthMismatch, but got %v", err)
+	}
+	if fail != nil {
+		t.Errorf("Expected OrderBy to return nil result, but got %v", fail)
+	}
+
+}
@@ -0,0 +1,146 @@
+package godash
+
+import (
+	"reflect"
+	"sort"
+)
+
+// SortedIndex uses a binary search to find the lowest index at which value should be inserted into the provided sorted slice to keep it sorted.
+// The slice must be sorted in ascending order and hold int, uint, float, string or time.Time elements, and value must be of the same type as the elements.
+func SortedIndex(slice interface{}, value interface{}) (int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("SortedIndex", 1, slice)
+	}
+	if sliceVal.Type().Elem() != reflect.TypeOf(value) {
+		return -1, typeMismatchError("SortedIndex", 2, sliceVal.Type().Elem(), value)
+	}
+
+	return sortedSearch("SortedIndex", sliceVal, value, nil, false)
+
+}
+
+// SortedLastIndex is like SortedIndex, except that it finds the highest index at which value should be inserted, i.e. the index after the last element equal to value.
+func SortedLastIndex(slice interface{}, value interface{}) (int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("SortedLastIndex", 1, slice)
+	}
+	if sliceVal.Type().Elem() != reflect.TypeOf(value) {
+		return -1, typeMismatchError("SortedLastIndex", 2, sliceVal.Type().Elem(), value)
+	}
+
+	return sortedSearch("SortedLastIndex", sliceVal, value, nil, true)
+
+}
+
+// SortedIndexOf uses a binary search to find the index of the first element of the provided sorted slice that equals value.
+// The slice must meet the same requirements as in SortedIndex. If the value is not found in the slice, -1 is returned.
+func SortedIndexOf(slice interface{}, value interface{}) (int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("SortedIndexOf", 1, slice)
+	}
+	if sliceVal.Type().Elem() != reflect.TypeOf(value) {
+		return -1, typeMismatchError("SortedIndexOf", 2, sliceVal.Type().Elem(), value)
+	}
+
+	i, err := sortedSearch("SortedIndexOf", sliceVal, value, nil, false)
+	if err != nil {
+		return -1, err
+	}
+	if i == sliceVal.Len() || kindComparer(keyKind(value))(sliceVal.Index(i).Interface(), value) != 0 {
+		return -1, nil
+	}
+	return i, nil
+
+}
+
+// SortedIndexBy is like SortedIndex, except that value and the elements of the slice are passed through a provided mutator function and compared by the resulting keys.
+// The supplied mutator function must accept an interface{} parameter and return an int, uint, float, string or time.Time key, using the same kind of key for every element.
+// The slice must be sorted in ascending order of these keys.
+func SortedIndexBy(slice interface{}, value interface{}, fn mutator) (int, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return -1, notSliceError("SortedIndexBy", 1, slice)
+	}
+	if sliceVal.Type().Elem() != reflect.TypeOf(value) {
+		return -1, typeMismatchError("SortedIndexBy", 2, sliceVal.Type().Elem(), value)
+	}
+	if fn == nil {
+		return -1, nilFuncError("SortedIndexBy", 3)
+	}
+
+	return sortedSearch("SortedIndexBy", sliceVal, value, fn, false)
+
+}
+
+// SortedUniq removes duplicate values from the provided sorted slice and returns the new slice.
+// Because equal elements of a sorted slice are adjacent, each element is only compared with its predecessor using reflect.DeepEqual,
+// which takes linear time and no hash map. NaN elements, which sort first, are treated as equal.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func SortedUniq(slice interface{}) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("SortedUniq", 1, slice)
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice).Elem()), 0, sliceVal.Len())
+
+	for i := 0; i < sliceVal.Len(); i++ {
+		if i == 0 || !deepEqual(sliceVal.Index(i).Interface(), sliceVal.Index(i-1).Interface()) {
+			dest = reflect.Append(dest, sliceVal.Index(i))
+		}
+	}
+	return dest.Interface(), nil
+
+}
+
+// sortedSearch performs the binary search of SortedIndex, SortedLastIndex and SortedIndexBy on behalf of function name.
+// Keys are the elements themselves if fn is nil. If last is true, the index after the last key equal to the key of value is returned.
+func sortedSearch(name string, sliceVal reflect.Value, value interface{}, fn mutator, last bool) (int, error) {
+
+	key := func(v interface{}) interface{} {
+		if fn == nil {
+			return v
+		}
+		return fn(v)
+	}
+
+	valueKey := key(value)
+	kind := keyKind(valueKey)
+	if kind == reflect.Invalid {
+		expected := "a slice of int, uint, float, string or time.Time elements"
+		got := typeName(sliceVal.Interface())
+		if fn != nil {
+			expected, got = "a function returning int, uint, float, string or time.Time keys", "a function returning "+typeName(valueKey)
+		}
+		return -1, &ArgumentError{Func: name, Param: 1, Expected: expected, Got: got, Err: ErrTypeMismatch}
+	}
+	compare := kindComparer(kind)
+
+	var err error
+	i := sort.Search(sliceVal.Len(), func(i int) bool {
+		elemKey := key(sliceVal.Index(i).Interface())
+		if keyKind(elemKey) != kind {
+			if err == nil {
+				err = &ArgumentError{Func: name, Param: 3, Expected: "a function returning keys of one kind", Got: "a function returning " + keyTypeNames([]interface{}{valueKey, elemKey}), Err: ErrTypeMismatch}
+			}
+			return true
+		}
+		if last {
+			return compare(elemKey, valueKey) > 0
+		}
+		return compare(elemKey, valueKey) >= 0
+	})
+	if err != nil {
+		return -1, err
+	}
+	return i, nil
+
+}
@@ -0,0 +1,160 @@
+package godash_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestSortedIndex(t *testing.T) {
+
+	source := []int{10, 20, 20, 20, 30}
+
+	// test for success
+	for value, expected := range map[int]int{5: 0, 20: 1, 25: 4, 40: 5} {
+		index, err := godash.SortedIndex(source, value)
+		if err != nil {
+			t.Errorf("Expected SortedIndex to return no error, but got %v", err)
+		}
+		if index != expected {
+			t.Errorf("Expected SortedIndex of %v to return %v, but it returned %v", value, expected, index)
+		}
+	}
+
+	// test for string success
+	index, err := godash.SortedIndex([]string{"apple", "banana", "orange"}, "cherry")
+	if err != nil {
+		t.Errorf("Expected SortedIndex to return no error, but got %v", err)
+	}
+	if index != 2 {
+		t.Errorf("Expected SortedIndex to return %v, but it returned %v", 2, index)
+	}
+
+	// test for failure
+	index, err = godash.SortedIndex(source, "20")
+	if !errors.Is(err, godash.ErrTypeMismatch) {
+		t.Errorf("Expected SortedIndex to return ErrTypeMismatch, but got %v", err)
+	}
+	if index != -1 {
+		t.Errorf("Expected SortedIndex to return %v, but it returned %v", -1, index)
+	}
+	index, err = godash.SortedIndex([]str{{name: "a"}}, str{name: "b"})
+	if !errors.Is(err, godash.ErrTypeMismatch) {
+		t.Errorf("Expected SortedIndex to return ErrTypeMismatch, but got %v", err)
+	}
+	if index != -1 {
+		t.Errorf("Expected SortedIndex to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestSortedLastIndex(t *testing.T) {
+
+	source := []int{10, 20, 20, 20, 30}
+
+	// test for success
+	for value, expected := range map[int]int{5: 0, 20: 4, 30: 5} {
+		index, err := godash.SortedLastIndex(source, value)
+		if err != nil {
+			t.Errorf("Expected SortedLastIndex to return no error, but got %v", err)
+		}
+		if index != expected {
+			t.Errorf("Expected SortedLastIndex of %v to return %v, but it returned %v", value, expected, index)
+		}
+	}
+
+	// test for failure
+	index, err := godash.SortedLastIndex(1, 1)
+	if err == nil {
+		t.Error("Expected SortedLastIndex to return error")
+	}
+	if index != -1 {
+		t.Errorf("Expected SortedLastIndex to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestSortedIndexOf(t *testing.T) {
+
+	source := []float64{1.5, 2.5, 2.5, 3.5}
+
+	// test for success
+	index, err := godash.SortedIndexOf(source, 2.5)
+	if err != nil {
+		t.Errorf("Expected SortedIndexOf to return no error, but got %v", err)
+	}
+	if index != 1 {
+		t.Errorf("Expected SortedIndexOf to return %v, but it returned %v", 1, index)
+	}
+
+	// test for not found
+	for _, value := range []float64{1.0, 3.0, 4.0} {
+		index, err = godash.SortedIndexOf(source, value)
+		if err != nil {
+			t.Errorf("Expected SortedIndexOf to return no error, but got %v", err)
+		}
+		if index != -1 {
+			t.Errorf("Expected SortedIndexOf of %v to return %v, but it returned %v", value, -1, index)
+		}
+	}
+
+}
+
+func TestSortedIndexBy(t *testing.T) {
+
+	fn := func(x interface{}) interface{} {
+		return x.(listing).price
+	}
+	source := []listing{{price: 100}, {price: 200}, {price: 300}}
+
+	// test for success
+	index, err := godash.SortedIndexBy(source, listing{price: 250}, fn)
+	if err != nil {
+		t.Errorf("Expected SortedIndexBy to return no error, but got %v", err)
+	}
+	if index != 2 {
+		t.Errorf("Expected SortedIndexBy to return %v, but it returned %v", 2, index)
+	}
+
+	// test for failure
+	index, err = godash.SortedIndexBy(source, listing{price: 250}, func(x interface{}) interface{} { return x })
+	if !errors.Is(err, godash.ErrTypeMismatch) {
+		t.Errorf("Expected SortedIndexBy to return ErrTypeMismatch, but got %v", err)
+	}
+	if index != -1 {
+		t.Errorf("Expected SortedIndexBy to return %v, but it returned %v", -1, index)
+	}
+	index, err = godash.SortedIndexBy(source, listing{}, nil)
+	if !errors.Is(err, godash.ErrNilFunc) {
+		t.Errorf("Expected SortedIndexBy to return ErrNilFunc, but got %v", err)
+	}
+	if index != -1 {
+		t.Errorf("Expected SortedIndexBy to return %v, but it returned %v", -1, index)
+	}
+
+}
+
+func TestSortedUniq(t *testing.T) {
+
+	// test for success
+	uniq, err := godash.SortedUniq([]int{1, 1, 2, 3, 3, 3, 4})
+	expected := []int{1, 2, 3, 4}
+	if err != nil {
+		t.Errorf("Expected SortedUniq to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(uniq, expected) {
+		t.Errorf("Expected SortedUniq to return %v, but it returned %v", expected, uniq)
+	}
+
+	// test for failure
+	uniq, err = godash.SortedUniq("abc")
+	if err == nil {
+		t.Error("Expected SortedUniq to return error")
+	}
+	if uniq != nil {
+		t.Errorf("Expected SortedUniq to return nil result, but got %v", uniq)
+	}
+
+}
@@ -0,0 +1,78 @@
+package godash
+
+import (
+	"reflect"
+)
+
+// Union creates a slice of unique values that were present in either of the provided slices.
+// The items of the first given slice come first in their original order, followed by the new items of the second slice.
+// Elements that cannot be used as map keys, such as slices or maps, are compared with reflect.DeepEqual.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func Union(slice1 interface{}, slice2 interface{}) (interface{}, error) {
+
+	sliceVal1 := reflect.ValueOf(slice1)
+	sliceVal2 := reflect.ValueOf(slice2)
+
+	if sliceVal1.Kind() != reflect.Slice {
+		return nil, notSliceError("Union", 1, slice1)
+	}
+	if sliceVal2.Kind() != reflect.Slice {
+		return nil, notSliceError("Union", 2, slice2)
+	}
+	if sliceVal1.Type().Elem() != sliceVal2.Type().Elem() {
+		return nil, typeMismatchError("Union", 2, reflect.SliceOf(sliceVal1.Type().Elem()), slice2)
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice1).Elem()), 0, sliceVal1.Len()+sliceVal2.Len())
+	m := newValueMap(sliceVal1.Len() + sliceVal2.Len())
+
+	for _, sliceVal := range []reflect.Value{sliceVal1, sliceVal2} {
+		for i := 0; i < sliceVal.Len(); i++ {
+			val := sliceVal.Index(i).Interface()
+			if !m.has(val) {
+				dest = reflect.Append(dest, sliceVal.Index(i))
+				m.add(val)
+			}
+		}
+	}
+	return dest.Interface(), nil
+
+}
+
+// UnionBy passes items from two provided slices through a provided mutator function and creates a new slice with the first item that resulted in each unique mutated value.
+// The supplied mutator function must accept an interface{} parameter and return interface{} with the value to be compared.
+// The items of the first given slice come first in their original order, followed by the new items of the second slice.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func UnionBy(slice1 interface{}, slice2 interface{}, fn mutator) (interface{}, error) {
+
+	sliceVal1 := reflect.ValueOf(slice1)
+	sliceVal2 := reflect.ValueOf(slice2)
+
+	if sliceVal1.Kind() != reflect.Slice {
+		return nil, notSliceError("UnionBy", 1, slice1)
+	}
+	if sliceVal2.Kind() != reflect.Slice {
+		return nil, notSliceError("UnionBy", 2, slice2)
+	}
+	if sliceVal1.Type().Elem() != sliceVal2.Type().Elem() {
+		return nil, typeMismatchError("UnionBy", 2, reflect.SliceOf(sliceVal1.Type().Elem()), slice2)
+	}
+	if fn == nil {
+		return nil, nilFuncError("UnionBy", 3)
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice1).Elem()), 0, sliceVal1.Len()+sliceVal2.Len())
+	m := newValueMap(sliceVal1.Len() + sliceVal2.Len())
+
+	for _, sliceVal := range []reflect.Value{sliceVal1, sliceVal2} {
+		for i := 0; i < sliceVal.Len(); i++ {
+			val := fn(sliceVal.Index(i).Interface())
+			if !m.has(val) {
+				dest = reflect.Append(dest, sliceVal.Index(i))
+				m.add(val)
+			}
+		}
+	}
+	return dest.Interface(), nil
+
+}
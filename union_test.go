@@ -0,0 +1,103 @@
+package godash_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestUnion(t *testing.T) {
+
+	// test int success
+	intSlice, err := godash.Union([]int{3, 17, 8, 3}, []int{8, 5, 17, 2, 5})
+	intExpected := []int{3, 17, 8, 5, 2}
+	if err != nil {
+		t.Errorf("Expected Union to return no error, but got %v", err)
+	}
+	if reflect.TypeOf(intSlice).Kind() != reflect.Slice {
+		t.Error("Expected Union to return slice")
+	}
+	if !reflect.DeepEqual(intSlice, intExpected) {
+		t.Errorf("Expected Union to return %v, but it returned %v", intExpected, intSlice)
+	}
+
+	// test unhashable success
+	nestedSlice, err := godash.Union([][]int{{1, 2}, {3}}, [][]int{{3}, {4}})
+	nestedExpected := [][]int{{1, 2}, {3}, {4}}
+	if err != nil {
+		t.Errorf("Expected Union to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(nestedSlice, nestedExpected) {
+		t.Errorf("Expected Union to return %v, but it returned %v", nestedExpected, nestedSlice)
+	}
+
+	// test failure
+	result, err := godash.Union(1, []int{1, 2})
+	if err == nil {
+		t.Error("Expected Union to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected Union to return nil result, but got %v", result)
+	}
+	result, err = godash.Union([]int{1, 2}, 1)
+	if err == nil {
+		t.Error("Expected Union to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected Union to return nil result, but got %v", result)
+	}
+	result, err = godash.Union([]float32{1, 2}, []int{1, 2})
+	if err == nil {
+		t.Error("Expected Union to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected Union to return nil result, but got %v", result)
+	}
+
+}
+
+func TestUnionBy(t *testing.T) {
+
+	fn := func(x interface{}) interface{} {
+		return math.Floor(x.(float64))
+	}
+
+	// test float success
+	floatSlice, err := godash.UnionBy([]float64{2.16, 1.23, 2.5}, []float64{5.78, 1.49, 3.7}, fn)
+	floatExpected := []float64{2.16, 1.23, 5.78, 3.7}
+	if err != nil {
+		t.Errorf("Expected UnionBy to return no error, but got %v", err)
+	}
+	if reflect.TypeOf(floatSlice).Kind() != reflect.Slice {
+		t.Error("Expected UnionBy to return slice")
+	}
+	if !reflect.DeepEqual(floatSlice, floatExpected) {
+		t.Errorf("Expected UnionBy to return %v, but it returned %v", floatExpected, floatSlice)
+	}
+
+	// test failure
+	result, err := godash.UnionBy(1, []int{1, 2}, fn)
+	if err == nil {
+		t.Error("Expected UnionBy to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected UnionBy to return nil result, but got %v", result)
+	}
+	result, err = godash.UnionBy([]int{1, 2}, 1, fn)
+	if err == nil {
+		t.Error("Expected UnionBy to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected UnionBy to return nil result, but got %v", result)
+	}
+	result, err = godash.UnionBy([]float32{1, 2}, []int{1, 2}, fn)
+	if err == nil {
+		t.Error("Expected UnionBy to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected UnionBy to return nil result, but got %v", result)
+	}
+
+}
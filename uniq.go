@@ -1,28 +1,90 @@
 package godash
 
 import (
-	"errors"
 	"reflect"
 )
 
 // Uniq removes duplicate values from a slice and returns the new slice.
+// Elements that implement Hasher are compared by their HashKey, and all NaN elements of a float32 or float64 slice are
+// treated as equal, so only the first is kept. UniqApprox treats floats within a tolerance as duplicates.
+// Elements that cannot be used as map keys, such as slices or maps, are compared with reflect.DeepEqual.
 // The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
 func Uniq(slice interface{}) (interface{}, error) {
 
 	sliceVal := reflect.ValueOf(slice)
-	if sliceVal.Type().Kind() != reflect.Slice {
-		return nil, errors.New("godash: invalid parameter type. Uniq func expects parameter 1 to be a slice")
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("Uniq", 1, slice)
 	}
 
 	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice).Elem()), 0, sliceVal.Len())
-	m := make(map[interface{}]bool)
+	m := newValueMap(sliceVal.Len())
 
 	for i := 0; i < sliceVal.Len(); i++ {
 		val := sliceVal.Index(i).Interface()
-		_, appended := m[val]
-		if !appended {
+		if !m.has(val) {
+			dest = reflect.Append(dest, sliceVal.Index(i))
+			m.add(val)
+		}
+	}
+	return dest.Interface(), nil
+
+}
+
+// UniqBy passes items from the provided slice through a provided mutator function and removes items that resulted in duplicate mutated values.
+// The supplied mutator function must accept an interface{} parameter and return interface{} with the value to be compared.
+// The first occurrence of each mutated value is kept and the original order is preserved.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func UniqBy(slice interface{}, fn mutator) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("UniqBy", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("UniqBy", 2)
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice).Elem()), 0, sliceVal.Len())
+	m := newValueMap(sliceVal.Len())
+
+	for i := 0; i < sliceVal.Len(); i++ {
+		val := fn(sliceVal.Index(i).Interface())
+		if !m.has(val) {
+			dest = reflect.Append(dest, sliceVal.Index(i))
+			m.add(val)
+		}
+	}
+	return dest.Interface(), nil
+
+}
+
+// UniqWith removes items from the provided slice that the provided comparator function reports as equal to an earlier item.
+// The supplied comparator function must accept two interface{} parameters and return true if they are considered equal.
+// The first occurrence of each value is kept and the original order is preserved.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func UniqWith(slice interface{}, fn comparator) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("UniqWith", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("UniqWith", 2)
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice).Elem()), 0, sliceVal.Len())
+
+	for i := 0; i < sliceVal.Len(); i++ {
+		val := sliceVal.Index(i).Interface()
+		duplicate := false
+		for j := 0; j < dest.Len(); j++ {
+			if fn(dest.Index(j).Interface(), val) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
 			dest = reflect.Append(dest, sliceVal.Index(i))
-			m[val] = true
 		}
 	}
 	return dest.Interface(), nil
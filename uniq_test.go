@@ -2,6 +2,7 @@ package godash_test
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/zillow/godash"
@@ -48,6 +49,36 @@ func TestUniq(t *testing.T) {
 		t.Errorf("Expected Uniq to return %v, but it returned %v", intExpected, intSlice)
 	}
 
+	// test for unhashable slice success
+	nestedSlice, err := godash.Uniq([][]string{{"a", "b"}, {"c"}, {"a", "b"}, {"b", "a"}, {"c"}})
+	nestedExpected := [][]string{{"a", "b"}, {"c"}, {"b", "a"}}
+	if err != nil {
+		t.Errorf("Expected Uniq to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(nestedSlice, nestedExpected) {
+		t.Errorf("Expected Uniq to return %v, but it returned %v", nestedExpected, nestedSlice)
+	}
+
+	// test for unhashable map success
+	mapSlice, err := godash.Uniq([]map[string]interface{}{{"id": 1.0, "tags": []interface{}{"a"}}, {"id": 2.0}, {"tags": []interface{}{"a"}, "id": 1.0}})
+	mapExpected := []map[string]interface{}{{"id": 1.0, "tags": []interface{}{"a"}}, {"id": 2.0}}
+	if err != nil {
+		t.Errorf("Expected Uniq to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(mapSlice, mapExpected) {
+		t.Errorf("Expected Uniq to return %v, but it returned %v", mapExpected, mapSlice)
+	}
+
+	// test for mixed hashable and unhashable interface values
+	mixedSlice, err := godash.Uniq([]interface{}{1, []int{1}, "1", []int{1}, 1})
+	mixedExpected := []interface{}{1, []int{1}, "1"}
+	if err != nil {
+		t.Errorf("Expected Uniq to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(mixedSlice, mixedExpected) {
+		t.Errorf("Expected Uniq to return %v, but it returned %v", mixedExpected, mixedSlice)
+	}
+
 	// test for failure
 	fail, err := godash.Uniq(str{name: "one"})
 	if err == nil {
@@ -58,3 +89,63 @@ func TestUniq(t *testing.T) {
 	}
 
 }
+
+func TestUniqBy(t *testing.T) {
+
+	fn := func(x interface{}) interface{} {
+		return x.(str).name
+	}
+
+	// test for success
+	structSlice, err := godash.UniqBy([]str{{name: "apple", foo: "bar"}, {name: "orange", foo: "bar"}, {name: "apple", foo: "-"}}, fn)
+	structExpected := []str{{name: "apple", foo: "bar"}, {name: "orange", foo: "bar"}}
+	if err != nil {
+		t.Errorf("Expected UniqBy to return no error, but got %v", err)
+	}
+	if reflect.TypeOf(structSlice).Kind() != reflect.Slice {
+		t.Error("Expected UniqBy to return slice")
+	}
+	if !reflect.DeepEqual(structSlice, structExpected) {
+		t.Errorf("Expected UniqBy to return %v, but it returned %v", structExpected, structSlice)
+	}
+
+	// test for failure
+	fail, err := godash.UniqBy(str{name: "one"}, fn)
+	if err == nil {
+		t.Error("Expected UniqBy to return error")
+	}
+	if fail != nil {
+		t.Errorf("Expected UniqBy to return nil result, but got %v", fail)
+	}
+
+}
+
+func TestUniqWith(t *testing.T) {
+
+	fn := func(a, b interface{}) bool {
+		return strings.EqualFold(a.(string), b.(string))
+	}
+
+	// test for success
+	stringSlice, err := godash.UniqWith([]string{"Apple", "orange", "apple", "ORANGE", "banana"}, fn)
+	stringExpected := []string{"Apple", "orange", "banana"}
+	if err != nil {
+		t.Errorf("Expected UniqWith to return no error, but got %v", err)
+	}
+	if reflect.TypeOf(stringSlice).Kind() != reflect.Slice {
+		t.Error("Expected UniqWith to return slice")
+	}
+	if !reflect.DeepEqual(stringSlice, stringExpected) {
+		t.Errorf("Expected UniqWith to return %v, but it returned %v", stringExpected, stringSlice)
+	}
+
+	// test for failure
+	fail, err := godash.UniqWith("apple", fn)
+	if err == nil {
+		t.Error("Expected UniqWith to return error")
+	}
+	if fail != nil {
+		t.Errorf("Expected UniqWith to return nil result, but got %v", fail)
+	}
+
+}
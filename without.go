@@ -1,38 +1,34 @@
 package godash
 
 import (
-	"errors"
 	"reflect"
 )
 
 // Without removes values from a slice and returns the new slice.
 // It accepts a slice of any type as the first parameter, followed by a list of parameter values to remove from the slice.
 // The additional values must be of the same type as the provided slice.
-// If using a basic type, such as string or int, it is recommended to use the more specific functions, such as WithoutString or WithoutInt.
-// Otherwise, if using this function directly, the returned result will need to have a type assertion applied.
+// Values are compared with reflect.DeepEqual, or with their Equal method if the element type implements Equaler.
+// When the element type is a plain comparable type, such as a number, string or struct of those, the values to remove
+// are put in a hash set instead, so large inputs are processed in linear time.
+// A NaN value removes every NaN element of a float32 or float64 slice; WithoutApprox matches floats within a tolerance.
+// The returned result will need to have a type assertion applied; generic.Without provides a type-safe alternative.
 func Without(slice interface{}, values ...interface{}) (interface{}, error) {
 
 	sliceVal := reflect.ValueOf(slice)
-	if sliceVal.Type().Kind() != reflect.Slice {
-		return nil, errors.New("godash: invalid parameter type. Without func expects parameter 1 to be a slice")
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("Without", 1, slice)
 	}
-	for _, v := range values {
+	for i, v := range values {
 		if sliceVal.Type().Elem() != reflect.TypeOf(v) {
-			return nil, errors.New("godash: invalid parameter type. Without func expects additional parameters to match the type of the provided slice")
+			return nil, typeMismatchError("Without", i+2, sliceVal.Type().Elem(), v)
 		}
 	}
 
 	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice).Elem()), 0, sliceVal.Len())
+	remove := valueMatcher(sliceVal.Type().Elem(), values)
 
 	for i := 0; i < sliceVal.Len(); i++ {
-		remove := false
-		for _, v := range values {
-			if reflect.DeepEqual(sliceVal.Index(i).Interface(), v) {
-				remove = true
-				break
-			}
-		}
-		if !remove {
+		if !remove(sliceVal.Index(i).Interface()) {
 			dest = reflect.Append(dest, sliceVal.Index(i))
 		}
 	}
@@ -41,6 +37,8 @@ func Without(slice interface{}, values ...interface{}) (interface{}, error) {
 }
 
 // WithoutString removes string values from a string slice
+//
+// Deprecated: use generic.Without, which is type-safe for any comparable element type.
 func WithoutString(slice []string, values ...interface{}) ([]string, error) {
 
 	result, err := Without(slice, values...)
@@ -52,6 +50,8 @@ func WithoutString(slice []string, values ...interface{}) ([]string, error) {
 }
 
 // WithoutInt removes int values from an int slice
+//
+// Deprecated: use generic.Without, which is type-safe for any comparable element type.
 func WithoutInt(slice []int, values ...interface{}) ([]int, error) {
 
 	result, err := Without(slice, values...)
@@ -63,6 +63,8 @@ func WithoutInt(slice []int, values ...interface{}) ([]int, error) {
 }
 
 // WithoutInt8 removes int8 values from an int8 slice
+//
+// Deprecated: use generic.Without, which is type-safe for any comparable element type.
 func WithoutInt8(slice []int8, values ...interface{}) ([]int8, error) {
 
 	result, err := Without(slice, values...)
@@ -74,6 +76,8 @@ func WithoutInt8(slice []int8, values ...interface{}) ([]int8, error) {
 }
 
 // WithoutFloat32 removes float32 values from a float32 slice
+//
+// Deprecated: use generic.Without, which is type-safe for any comparable element type.
 func WithoutFloat32(slice []float32, values ...interface{}) ([]float32, error) {
 
 	result, err := Without(slice, values...)
@@ -84,14 +88,48 @@ func WithoutFloat32(slice []float32, values ...interface{}) ([]float32, error) {
 
 }
 
+// WithoutWith removes values from a slice that the provided comparator function reports equal to any of the values,
+// and returns the new slice.
+// The supplied function must accept two interface{} parameters, an element and a value to remove, and return bool.
+// The additional values must be of the same type as the provided slice.
+func WithoutWith(slice interface{}, fn comparator, values ...interface{}) (interface{}, error) {
+
+	sliceVal := reflect.ValueOf(slice)
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("WithoutWith", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("WithoutWith", 2)
+	}
+	for i, v := range values {
+		if sliceVal.Type().Elem() != reflect.TypeOf(v) {
+			return nil, typeMismatchError("WithoutWith", i+3, sliceVal.Type().Elem(), v)
+		}
+	}
+
+	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice).Elem()), 0, sliceVal.Len())
+	remove := matcherWith(fn, values)
+
+	for i := 0; i < sliceVal.Len(); i++ {
+		if !remove(sliceVal.Index(i).Interface()) {
+			dest = reflect.Append(dest, sliceVal.Index(i))
+		}
+	}
+	return dest.Interface(), nil
+
+}
+
 // WithoutBy removes values from a slice based on output from a provided validator function and returns the new slice.
 // The supplied function must accept an interface{} parameter and return bool.
 // Values for which the validator function returns true will be removed from the slice.
 func WithoutBy(slice interface{}, fn validator) (interface{}, error) {
 
 	sliceVal := reflect.ValueOf(slice)
-	if sliceVal.Type().Kind() != reflect.Slice {
-		return nil, errors.New("godash: invalid parameter type. WithoutBy func expects parameter 1 to be a slice")
+	if sliceVal.Kind() != reflect.Slice {
+		return nil, notSliceError("WithoutBy", 1, slice)
+	}
+	if fn == nil {
+		return nil, nilFuncError("WithoutBy", 2)
 	}
 
 	dest := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(slice).Elem()), 0, sliceVal.Len())
@@ -105,3 +143,37 @@ func WithoutBy(slice interface{}, fn validator) (interface{}, error) {
 	return dest.Interface(), nil
 
 }
+
+// valueMatcher returns a function reporting whether an element of type t equals any of values.
+// Elements are compared with the equality defined by t if it has one, and otherwise with reflect.DeepEqual,
+// using a hash set instead when t is a plain comparable type.
+func valueMatcher(t reflect.Type, values []interface{}) func(interface{}) bool {
+
+	if eq := equalFunc(t); eq != nil {
+		return matcherWith(eq, values)
+	}
+	if isFlatComparable(t) {
+		m := make(map[interface{}]bool, len(values))
+		for _, v := range values {
+			m[normalizeNaN(v)] = true
+		}
+		return func(x interface{}) bool {
+			return m[normalizeNaN(x)]
+		}
+	}
+
+	return matcherWith(deepEqual, values)
+
+}
+
+// matcherWith returns a function reporting whether fn reports an element equal to any of values.
+func matcherWith(fn comparator, values []interface{}) func(interface{}) bool {
+	return func(x interface{}) bool {
+		for _, v := range values {
+			if fn(x, v) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -1,6 +1,7 @@
 package godash_test
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -52,6 +53,16 @@ func TestWithout(t *testing.T) {
 		t.Errorf("Expected Without to return %v, but it returned %v", structExpected, structDest)
 	}
 
+	// test for pointer success, which compares what the pointers refer to
+	one, two, otherTwo := 1, 2, 2
+	pointerDest, err := godash.Without([]*int{&one, &two}, &otherTwo)
+	if err != nil {
+		t.Errorf("Expected Without to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(pointerDest, []*int{&one}) {
+		t.Errorf("Expected Without to return %v, but it returned %v", []*int{&one}, pointerDest)
+	}
+
 	// test for failure
 	failDest, err := godash.Without(stringSource, 1, 2)
 	if err == nil {
@@ -147,6 +158,43 @@ func TestWithoutFloat32(t *testing.T) {
 
 }
 
+func TestWithoutWith(t *testing.T) {
+
+	fn := func(x, value interface{}) bool {
+		return x.(str).name == value.(str).name
+	}
+	structSource := []str{{name: "first", foo: "bar"}, {name: "second"}, {name: "third"}}
+
+	// test for success
+	result, err := godash.WithoutWith(structSource, fn, str{name: "first"}, str{name: "third"})
+	if err != nil {
+		t.Errorf("Expected WithoutWith to return no error, but got %v", err)
+	}
+	expected := []str{{name: "second"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected WithoutWith to return %v, but it returned %v", expected, result)
+	}
+
+	// test for type mismatch
+	result, err = godash.WithoutWith(structSource, fn, "first")
+	if err == nil {
+		t.Error("Expected WithoutWith to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected WithoutWith to return nil, but it returned %v", result)
+	}
+
+	// test for nil function
+	result, err = godash.WithoutWith(structSource, nil)
+	if err == nil {
+		t.Error("Expected WithoutWith to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected WithoutWith to return nil, but it returned %v", result)
+	}
+
+}
+
 func TestWithoutBy(t *testing.T) {
 
 	source := []int{1, 2, 3, 4, 5, 6}
@@ -175,3 +223,88 @@ func TestWithoutBy(t *testing.T) {
 	}
 
 }
+
+func benchmarkWithout(b *testing.B, source interface{}, values []interface{}) {
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := godash.Without(source, values...); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+}
+
+// withoutSizes lists the source and value counts that the Without benchmarks run with, so their results can be compared.
+var withoutSizes = []struct{ n, m int }{{1000, 100}, {10000, 1000}}
+
+// intWithoutInput builds an int slice of n elements and m evenly spread values to remove from it.
+func intWithoutInput(n, m int) ([]int, []interface{}) {
+
+	source := make([]int, n)
+	for i := range source {
+		source[i] = i
+	}
+	values := make([]interface{}, m)
+	for i := range values {
+		values[i] = i * (n / m)
+	}
+	return source, values
+
+}
+
+// BenchmarkWithoutComparable strips values from an int slice, which uses the hash set fast path.
+func BenchmarkWithoutComparable(b *testing.B) {
+
+	for _, size := range withoutSizes {
+		source, values := intWithoutInput(size.n, size.m)
+		b.Run(fmt.Sprintf("%dx%d", size.n, size.m), func(b *testing.B) {
+			benchmarkWithout(b, source, values)
+		})
+	}
+
+}
+
+// BenchmarkWithoutDeepEqual strips the same values from the same int slices as BenchmarkWithoutComparable,
+// but compares every pair with reflect.DeepEqual, as Without did before the hash set fast path.
+func BenchmarkWithoutDeepEqual(b *testing.B) {
+
+	for _, size := range withoutSizes {
+		source, values := intWithoutInput(size.n, size.m)
+		remove := func(x interface{}) bool {
+			for _, v := range values {
+				if reflect.DeepEqual(x, v) {
+					return true
+				}
+			}
+			return false
+		}
+		b.Run(fmt.Sprintf("%dx%d", size.n, size.m), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := godash.WithoutBy(source, remove); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+
+}
+
+// BenchmarkWithoutNonComparable strips values from a slice of slices, which falls back to comparing every pair with reflect.DeepEqual.
+func BenchmarkWithoutNonComparable(b *testing.B) {
+
+	for _, size := range withoutSizes {
+		source := make([][]int, size.n)
+		for i := range source {
+			source[i] = []int{i}
+		}
+		values := make([]interface{}, size.m)
+		for i := range values {
+			values[i] = []int{i * (size.n / size.m)}
+		}
+		b.Run(fmt.Sprintf("%dx%d", size.n, size.m), func(b *testing.B) {
+			benchmarkWithout(b, source, values)
+		})
+	}
+
+}
@@ -0,0 +1,87 @@
+package godash
+
+import (
+	"reflect"
+)
+
+// Xor creates a slice of unique values that were present in exactly one of the provided slices, i.e. their symmetric difference.
+// The items taken from the first given slice come first in their original order, followed by the items taken from the second slice.
+// Elements that cannot be used as map keys, such as slices or maps, are compared with reflect.DeepEqual.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func Xor(slice1 interface{}, slice2 interface{}) (interface{}, error) {
+
+	sliceVal1 := reflect.ValueOf(slice1)
+	sliceVal2 := reflect.ValueOf(slice2)
+
+	if sliceVal1.Kind() != reflect.Slice {
+		return nil, notSliceError("Xor", 1, slice1)
+	}
+	if sliceVal2.Kind() != reflect.Slice {
+		return nil, notSliceError("Xor", 2, slice2)
+	}
+	if sliceVal1.Type().Elem() != sliceVal2.Type().Elem() {
+		return nil, typeMismatchError("Xor", 2, reflect.SliceOf(sliceVal1.Type().Elem()), slice2)
+	}
+
+	return xor(sliceVal1, sliceVal2, func(v interface{}) interface{} { return v }), nil
+
+}
+
+// XorBy passes items from two provided slices through a provided mutator function and creates a new slice with the items whose mutated values were present in exactly one of the slices.
+// The supplied mutator function must accept an interface{} parameter and return interface{} with the value to be compared.
+// The first item that resulted in each such mutated value is kept. Items taken from the first given slice come first, followed by items taken from the second slice.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func XorBy(slice1 interface{}, slice2 interface{}, fn mutator) (interface{}, error) {
+
+	sliceVal1 := reflect.ValueOf(slice1)
+	sliceVal2 := reflect.ValueOf(slice2)
+
+	if sliceVal1.Kind() != reflect.Slice {
+		return nil, notSliceError("XorBy", 1, slice1)
+	}
+	if sliceVal2.Kind() != reflect.Slice {
+		return nil, notSliceError("XorBy", 2, slice2)
+	}
+	if sliceVal1.Type().Elem() != sliceVal2.Type().Elem() {
+		return nil, typeMismatchError("XorBy", 2, reflect.SliceOf(sliceVal1.Type().Elem()), slice2)
+	}
+	if fn == nil {
+		return nil, nilFuncError("XorBy", 3)
+	}
+
+	return xor(sliceVal1, sliceVal2, fn), nil
+
+}
+
+// xor implements Xor and XorBy on validated slices.
+func xor(sliceVal1 reflect.Value, sliceVal2 reflect.Value, fn mutator) interface{} {
+
+	dest := reflect.MakeSlice(reflect.SliceOf(sliceVal1.Type().Elem()), 0, sliceVal1.Len()+sliceVal2.Len())
+	sliceVals := []reflect.Value{sliceVal1, sliceVal2}
+
+	// sources records which of the two slices (1, 2, or 3 for both) each value was seen in
+	sources := newValueMap(sliceVal1.Len() + sliceVal2.Len())
+	for s, sliceVal := range sliceVals {
+		for i := 0; i < sliceVal.Len(); i++ {
+			val := fn(sliceVal.Index(i).Interface())
+			source, _ := sources.get(val)
+			sources.set(val, source|(1<<s))
+		}
+	}
+
+	appended := newValueMap(sliceVal1.Len() + sliceVal2.Len())
+	for s, sliceVal := range sliceVals {
+		for i := 0; i < sliceVal.Len(); i++ {
+			val := fn(sliceVal.Index(i).Interface())
+			if source, _ := sources.get(val); source != 1<<s {
+				continue
+			}
+			if !appended.has(val) {
+				dest = reflect.Append(dest, sliceVal.Index(i))
+				appended.add(val)
+			}
+		}
+	}
+	return dest.Interface()
+
+}
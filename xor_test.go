@@ -0,0 +1,103 @@
+package godash_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestXor(t *testing.T) {
+
+	// test int success
+	intSlice, err := godash.Xor([]int{3, 17, 8, 3, 11}, []int{8, 5, 17, 5, 2})
+	intExpected := []int{3, 11, 5, 2}
+	if err != nil {
+		t.Errorf("Expected Xor to return no error, but got %v", err)
+	}
+	if reflect.TypeOf(intSlice).Kind() != reflect.Slice {
+		t.Error("Expected Xor to return slice")
+	}
+	if !reflect.DeepEqual(intSlice, intExpected) {
+		t.Errorf("Expected Xor to return %v, but it returned %v", intExpected, intSlice)
+	}
+
+	// test unhashable success
+	nestedSlice, err := godash.Xor([][]int{{1, 2}, {3}}, [][]int{{3}, {4}})
+	nestedExpected := [][]int{{1, 2}, {4}}
+	if err != nil {
+		t.Errorf("Expected Xor to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(nestedSlice, nestedExpected) {
+		t.Errorf("Expected Xor to return %v, but it returned %v", nestedExpected, nestedSlice)
+	}
+
+	// test failure
+	result, err := godash.Xor(1, []int{1, 2})
+	if err == nil {
+		t.Error("Expected Xor to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected Xor to return nil result, but got %v", result)
+	}
+	result, err = godash.Xor([]int{1, 2}, 1)
+	if err == nil {
+		t.Error("Expected Xor to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected Xor to return nil result, but got %v", result)
+	}
+	result, err = godash.Xor([]float32{1, 2}, []int{1, 2})
+	if err == nil {
+		t.Error("Expected Xor to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected Xor to return nil result, but got %v", result)
+	}
+
+}
+
+func TestXorBy(t *testing.T) {
+
+	fn := func(x interface{}) interface{} {
+		return math.Floor(x.(float64))
+	}
+
+	// test float success
+	floatSlice, err := godash.XorBy([]float64{2.16, 1.23, 5.4}, []float64{5.78, 2.49, 3.7, 3.1}, fn)
+	floatExpected := []float64{1.23, 3.7}
+	if err != nil {
+		t.Errorf("Expected XorBy to return no error, but got %v", err)
+	}
+	if reflect.TypeOf(floatSlice).Kind() != reflect.Slice {
+		t.Error("Expected XorBy to return slice")
+	}
+	if !reflect.DeepEqual(floatSlice, floatExpected) {
+		t.Errorf("Expected XorBy to return %v, but it returned %v", floatExpected, floatSlice)
+	}
+
+	// test failure
+	result, err := godash.XorBy(1, []int{1, 2}, fn)
+	if err == nil {
+		t.Error("Expected XorBy to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected XorBy to return nil result, but got %v", result)
+	}
+	result, err = godash.XorBy([]int{1, 2}, 1, fn)
+	if err == nil {
+		t.Error("Expected XorBy to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected XorBy to return nil result, but got %v", result)
+	}
+	result, err = godash.XorBy([]float32{1, 2}, []int{1, 2}, fn)
+	if err == nil {
+		t.Error("Expected XorBy to return error")
+	}
+	if result != nil {
+		t.Errorf("Expected XorBy to return nil result, but got %v", result)
+	}
+
+}
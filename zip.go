@@ -0,0 +1,152 @@
+package godash
+
+import (
+	"reflect"
+)
+
+// Zip groups the elements of the provided slices by index, creating a slice whose first element holds the first elements
+// of each slice, whose second element holds the second elements of each slice, and so on.
+// The result is as long as the longest slice; shorter slices are padded with the zero value of their element type.
+// Use ZipShortest to stop at the end of the shortest slice instead.
+func Zip(slices ...interface{}) ([][]interface{}, error) {
+
+	sliceVals, err := zipSlices("Zip", 1, slices)
+	if err != nil {
+		return nil, err
+	}
+	return zip(sliceVals, false), nil
+
+}
+
+// ZipShortest is like Zip, except that the result is only as long as the shortest slice.
+func ZipShortest(slices ...interface{}) ([][]interface{}, error) {
+
+	sliceVals, err := zipSlices("ZipShortest", 1, slices)
+	if err != nil {
+		return nil, err
+	}
+	return zip(sliceVals, true), nil
+
+}
+
+// ZipWith is like Zip, except that the elements at each index are combined by the provided zipper function instead of being grouped into a slice.
+// The supplied function must accept one interface{} parameter per provided slice and return interface{}.
+// The element type of the new slice is the type of the results if they all share the same type, otherwise it is interface{}.
+// The new slice is returned as an interface{} and may need to have a type assertion applied to it afterwards.
+func ZipWith(fn zipper, slices ...interface{}) (interface{}, error) {
+
+	if fn == nil {
+		return nil, nilFuncError("ZipWith", 1)
+	}
+	sliceVals, err := zipSlices("ZipWith", 2, slices)
+	if err != nil {
+		return nil, err
+	}
+	return zipWith(fn, zip(sliceVals, false)), nil
+
+}
+
+// ZipWithShortest is like ZipWith, except that the result is only as long as the shortest slice.
+func ZipWithShortest(fn zipper, slices ...interface{}) (interface{}, error) {
+
+	if fn == nil {
+		return nil, nilFuncError("ZipWithShortest", 1)
+	}
+	sliceVals, err := zipSlices("ZipWithShortest", 2, slices)
+	if err != nil {
+		return nil, err
+	}
+	return zipWith(fn, zip(sliceVals, true)), nil
+
+}
+
+// Unzip reverses Zip: it accepts a slice of slices, such as the [][]interface{} returned by Zip, and regroups their elements by index.
+// The first slice returned holds the first element of each provided slice, the second slice holds the second elements, and so on.
+// Each returned slice has the type of its elements if they all share the same type, otherwise it is a []interface{}.
+// Missing elements of provided slices that are shorter than the longest one are nil.
+func Unzip(zipped interface{}) ([]interface{}, error) {
+
+	zippedVal := reflect.ValueOf(zipped)
+	if zippedVal.Kind() != reflect.Slice {
+		return nil, notSliceError("Unzip", 1, zipped)
+	}
+
+	rows := make([]reflect.Value, zippedVal.Len())
+	width := 0
+	for i := range rows {
+		rows[i] = zippedVal.Index(i)
+		if rows[i].Kind() == reflect.Interface {
+			rows[i] = rows[i].Elem()
+		}
+		if rows[i].Kind() != reflect.Slice {
+			return nil, &ArgumentError{Func: "Unzip", Param: 1, Expected: "a slice of slices", Got: "an element of type " + typeName(zippedVal.Index(i).Interface()), Err: ErrNotSlice}
+		}
+		if rows[i].Len() > width {
+			width = rows[i].Len()
+		}
+	}
+
+	dest := make([]interface{}, width)
+	for j := range dest {
+		column := make([]interface{}, len(rows))
+		for i, row := range rows {
+			if j < row.Len() {
+				column[i] = row.Index(j).Interface()
+			}
+		}
+		dest[j] = typedSlice(column)
+	}
+	return dest, nil
+
+}
+
+// zipSlices validates the slices passed to function name, whose first slice is parameter firstParam.
+func zipSlices(name string, firstParam int, slices []interface{}) ([]reflect.Value, error) {
+
+	sliceVals := make([]reflect.Value, len(slices))
+	for i, slice := range slices {
+		sliceVals[i] = reflect.ValueOf(slice)
+		if sliceVals[i].Kind() != reflect.Slice {
+			return nil, notSliceError(name, firstParam+i, slice)
+		}
+	}
+	return sliceVals, nil
+
+}
+
+// zip groups the elements of sliceVals by index, up to the length of the shortest slice if shortest is true,
+// or up to the length of the longest slice, padding with zero values, if it is false.
+func zip(sliceVals []reflect.Value, shortest bool) [][]interface{} {
+
+	n := 0
+	for i, sliceVal := range sliceVals {
+		if i == 0 || (shortest && sliceVal.Len() < n) || (!shortest && sliceVal.Len() > n) {
+			n = sliceVal.Len()
+		}
+	}
+
+	dest := make([][]interface{}, n)
+	for j := range dest {
+		dest[j] = make([]interface{}, len(sliceVals))
+		for i, sliceVal := range sliceVals {
+			if j < sliceVal.Len() {
+				dest[j][i] = sliceVal.Index(j).Interface()
+			} else {
+				dest[j][i] = reflect.Zero(sliceVal.Type().Elem()).Interface()
+			}
+		}
+	}
+	return dest
+
+}
+
+// zipWith combines each group of zipped elements with fn.
+func zipWith(fn zipper, zipped [][]interface{}) interface{} {
+
+	results := make([]interface{}, len(zipped))
+	for i, group := range zipped {
+		results[i] = fn(group...)
+	}
+	return typedSlice(results)
+
+}
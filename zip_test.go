@@ -0,0 +1,115 @@
+package godash_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/zillow/godash"
+)
+
+func TestZip(t *testing.T) {
+
+	ids := []int{1, 2, 3}
+	prices := []float64{9.5, 12}
+
+	// test for padded success
+	zipped, err := godash.Zip(ids, prices)
+	expected := [][]interface{}{{1, 9.5}, {2, 12.0}, {3, 0.0}}
+	if err != nil {
+		t.Errorf("Expected Zip to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(zipped, expected) {
+		t.Errorf("Expected Zip to return %v, but it returned %v", expected, zipped)
+	}
+
+	// test for truncated success
+	zipped, err = godash.ZipShortest(ids, prices)
+	expected = [][]interface{}{{1, 9.5}, {2, 12.0}}
+	if err != nil {
+		t.Errorf("Expected ZipShortest to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(zipped, expected) {
+		t.Errorf("Expected ZipShortest to return %v, but it returned %v", expected, zipped)
+	}
+
+	// test for failure
+	zipped, err = godash.Zip(ids, 1)
+	if err == nil {
+		t.Error("Expected Zip to return error")
+	}
+	if zipped != nil {
+		t.Errorf("Expected Zip to return nil result, but got %v", zipped)
+	}
+
+}
+
+func TestUnzip(t *testing.T) {
+
+	// test for success
+	unzipped, err := godash.Unzip([][]interface{}{{1, 9.5}, {2, 12.0}, {3, 0.0}})
+	expected := []interface{}{[]int{1, 2, 3}, []float64{9.5, 12, 0}}
+	if err != nil {
+		t.Errorf("Expected Unzip to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(unzipped, expected) {
+		t.Errorf("Expected Unzip to return %v, but it returned %v", expected, unzipped)
+	}
+
+	// test for uneven success
+	unzipped, err = godash.Unzip([][]string{{"a", "b"}, {"c"}})
+	expected = []interface{}{[]string{"a", "c"}, []interface{}{"b", nil}}
+	if err != nil {
+		t.Errorf("Expected Unzip to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(unzipped, expected) {
+		t.Errorf("Expected Unzip to return %v, but it returned %v", expected, unzipped)
+	}
+
+	// test for failure
+	unzipped, err = godash.Unzip([]int{1, 2})
+	if err == nil {
+		t.Error("Expected Unzip to return error")
+	}
+	if unzipped != nil {
+		t.Errorf("Expected Unzip to return nil result, but got %v", unzipped)
+	}
+
+}
+
+func TestZipWith(t *testing.T) {
+
+	fn := func(values ...interface{}) interface{} {
+		return fmt.Sprintf("%v:%v", values[0], values[1])
+	}
+
+	// test for padded success
+	zipped, err := godash.ZipWith(fn, []string{"a", "b", "c"}, []int{1, 2})
+	expected := []string{"a:1", "b:2", "c:0"}
+	if err != nil {
+		t.Errorf("Expected ZipWith to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(zipped, expected) {
+		t.Errorf("Expected ZipWith to return %v, but it returned %v", expected, zipped)
+	}
+
+	// test for truncated success
+	zipped, err = godash.ZipWithShortest(fn, []string{"a", "b", "c"}, []int{1, 2})
+	expected = []string{"a:1", "b:2"}
+	if err != nil {
+		t.Errorf("Expected ZipWithShortest to return no error, but got %v", err)
+	}
+	if !reflect.DeepEqual(zipped, expected) {
+		t.Errorf("Expected ZipWithShortest to return %v, but it returned %v", expected, zipped)
+	}
+
+	// test for failure
+	zipped, err = godash.ZipWith(nil, []int{1})
+	if err == nil {
+		t.Error("Expected ZipWith to return error")
+	}
+	if zipped != nil {
+		t.Errorf("Expected ZipWith to return nil result, but got %v", zipped)
+	}
+
+}